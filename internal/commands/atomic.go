@@ -0,0 +1,177 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// atomicPlanning makes actions plan their operations while an atomic batch is
+// being prepared.
+var atomicPlanning bool
+
+// previewing reports whether actions should only plan their operations.
+func previewing() bool {
+	return dryRun || atomicPlanning
+}
+
+// atomicHelp documents --atomic in command help.
+const atomicHelp = "With --atomic, every action is planned first and the batch is applied completely or not at all; a conflict or error aborts it before anything changes, and a batch interrupted midway is rolled back by the next bond command."
+
+// batchOptions holds the flags of commands that act on a batch of project skills.
+type batchOptions struct {
+	selectors selectorOptions
+	// atomic applies the whole batch or nothing.
+	atomic bool
+}
+
+// addBatchFlags registers the selector flags and --atomic on cmd.
+func addBatchFlags(cmd *cobra.Command, opts *batchOptions) {
+	addSelectorFlags(cmd, &opts.selectors)
+	cmd.Flags().BoolVar(&opts.atomic, "atomic", false, "Plan every action first, then apply all of them or none")
+}
+
+// plannedAction is one action result of an atomic batch, printed once the batch is applied.
+type plannedAction struct {
+	name   string
+	output skillActionOutput
+}
+
+// runAtomicSkillActions plans one action per skill and target, then applies
+// every planned operation under an on-disk journal. If any action cannot be
+// planned, nothing is applied; if applying fails, everything is rolled back.
+func runAtomicSkillActions(
+	cmd *cobra.Command,
+	selected []skills.Skill,
+	targets []projectTarget,
+	action func(skill skills.Skill, target projectTarget) (skillActionOutput, error),
+) error {
+	planned, failures, err := planAtomicSkillActions(cmd, selected, targets, action)
+	if err != nil {
+		return err
+	}
+	if failures > 0 {
+		return fmt.Errorf("atomic %s aborted: %d of %d actions cannot be applied; nothing was changed", cmd.Name(), failures, failures+len(planned))
+	}
+
+	if !dryRun {
+		if err := applyAtomicBatch(cmd.Name(), planned); err != nil {
+			return err
+		}
+	}
+
+	for _, action := range planned {
+		if err := printSkillAction(cmd, action.name, action.output); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// planAtomicSkillActions runs every action in planning mode. Errors and
+// warnings, such as conflicts, are printed and counted as failures.
+func planAtomicSkillActions(
+	cmd *cobra.Command,
+	selected []skills.Skill,
+	targets []projectTarget,
+	action func(skill skills.Skill, target projectTarget) (skillActionOutput, error),
+) ([]plannedAction, int, error) {
+	atomicPlanning = true
+	defer func() { atomicPlanning = false }()
+
+	planned := []plannedAction{}
+	failures := 0
+	for _, skill := range selected {
+		for _, target := range targets {
+			targetName := actionTargetName(targets, target)
+
+			output, err := action(skill, target)
+			if err != nil {
+				failures++
+				if printErrErr := printSkillActionError(cmd, skill.Name, targetName, err); printErrErr != nil {
+					return nil, 0, printErrErr
+				}
+				continue
+			}
+
+			output.target = targetName
+			if output.level == levelWarn {
+				failures++
+				if err := printSkillAction(cmd, skill.Name, output); err != nil {
+					return nil, 0, err
+				}
+				continue
+			}
+			planned = append(planned, plannedAction{name: skill.Name, output: output})
+		}
+	}
+	return planned, failures, nil
+}
+
+// applyAtomicBatch applies the operations of planned and their bookkeeping,
+// rolling everything back on the first failure.
+func applyAtomicBatch(command string, planned []plannedAction) error {
+	ops := []skills.Operation{}
+	for _, action := range planned {
+		ops = append(ops, action.output.ops...)
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+
+	journalPath, err := config.ProjectTransactionFile()
+	if err != nil {
+		return err
+	}
+	tx, err := skills.BeginTransaction(journalPath, command, ops)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Apply(); err != nil {
+		return rollbackAtomicBatch(command, tx, err)
+	}
+	for _, action := range planned {
+		if action.output.commit == nil {
+			continue
+		}
+		if err := action.output.commit(); err != nil {
+			return rollbackAtomicBatch(command, tx, fmt.Errorf("%s: %w", action.name, err))
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	recordApplied(tx.Operations())
+	return nil
+}
+
+// rollbackAtomicBatch reverts tx after cause stopped it.
+func rollbackAtomicBatch(command string, tx *skills.Transaction, cause error) error {
+	if err := tx.Rollback(); err != nil {
+		return fmt.Errorf("atomic %s failed: %v; rollback incomplete: %v", command, cause, err)
+	}
+	return fmt.Errorf("atomic %s failed and was rolled back: %w", command, cause)
+}
+
+// recoverInterruptedBatch rolls back an atomic batch that a previous run left
+// half applied, and says so on stderr.
+func recoverInterruptedBatch(cmd *cobra.Command) error {
+	journalPath, err := config.ProjectTransactionFile()
+	if err != nil {
+		return err
+	}
+
+	journal, ok, err := skills.RecoverTransaction(journalPath)
+	if !ok {
+		return err
+	}
+	started := journal.StartedAt.Local().Format(time.RFC3339)
+	if err != nil {
+		return printErr(cmd, levelWarn, "rolled back interrupted %s batch from %s with problems: %v", journal.Command, started, err)
+	}
+	return printErr(cmd, levelWarn, "rolled back interrupted %s batch from %s", journal.Command, started)
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+)
+
+func TestAtomicLinkAbortsWholeBatchOnConflict(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	mustMkdirAll(t, filepath.Join(filepath.Dir(storeSkill), "rust"))
+	if err := os.WriteFile(filepath.Join(filepath.Dir(storeSkill), "rust", "SKILL.md"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile(rust) error = %v", err)
+	}
+	skillsDir := filepath.Join(projectRoot, ".agents", "skills")
+	mustMkdirAll(t, filepath.Join(skillsDir, "rust"))
+
+	output, err := executeRootForTest(t, "link", "--atomic", "go", "rust")
+	if err == nil || err.Error() != "atomic link aborted: 1 of 2 actions cannot be applied; nothing was changed" {
+		t.Fatalf("Execute(link --atomic) error = %v, want aborted batch", err)
+	}
+	if want := "[WARN] conflict rust\n"; output != want {
+		t.Fatalf("output = %q, want %q", output, want)
+	}
+	if _, err := os.Lstat(filepath.Join(skillsDir, "go")); !os.IsNotExist(err) {
+		t.Fatalf("Lstat(go) error = %v, want not linked", err)
+	}
+}
+
+func TestAtomicCopyAppliesBatchAndRecordsLock(t *testing.T) {
+	_, projectRoot := setupGoSkillProject(t)
+
+	output, err := executeRootForTest(t, "copy", "--atomic", "go")
+	if err != nil {
+		t.Fatalf("Execute(copy --atomic) error = %v", err)
+	}
+	if want := "[OK] copied go\n"; output != want {
+		t.Fatalf("output = %q, want %q", output, want)
+	}
+	if _, err := os.Stat(filepath.Join(projectRoot, ".agents", "skills", "go", "SKILL.md")); err != nil {
+		t.Fatalf("Stat(copy) error = %v", err)
+	}
+	lock, err := skills.LoadLockfile(config.ProjectLockFrom(projectRoot))
+	if err != nil {
+		t.Fatalf("LoadLockfile() error = %v", err)
+	}
+	if _, ok := lock.Skills["go"]; !ok {
+		t.Fatalf("lock.Skills = %#v, want go entry", lock.Skills)
+	}
+	if _, err := os.Stat(filepath.Join(config.ProjectBaseDirFrom(projectRoot), "go", "SKILL.md")); err != nil {
+		t.Fatalf("Stat(base snapshot) error = %v", err)
+	}
+	if _, err := os.Stat(config.ProjectTransactionFrom(projectRoot)); !os.IsNotExist(err) {
+		t.Fatalf("Stat(transaction) error = %v, want removed", err)
+	}
+}
+
+func TestNextCommandRollsBackInterruptedBatch(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	link := filepath.Join(projectRoot, ".agents", "skills", "go")
+	journalPath := config.ProjectTransactionFrom(projectRoot)
+
+	tx, err := skills.BeginTransaction(journalPath, "link", []skills.Operation{{Kind: skills.OpSymlink, Path: link, Source: storeSkill}})
+	if err != nil {
+		t.Fatalf("BeginTransaction() error = %v", err)
+	}
+	if err := tx.Apply(); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "init")
+	if err != nil {
+		t.Fatalf("Execute(init) error = %v", err)
+	}
+	if !strings.HasPrefix(output, "[WARN] rolled back interrupted link batch from ") {
+		t.Fatalf("output = %q, want rollback warning", output)
+	}
+	if _, err := os.Lstat(link); !os.IsNotExist(err) {
+		t.Fatalf("Lstat(link) error = %v, want rolled back", err)
+	}
+	if _, err := os.Stat(journalPath); !os.IsNotExist(err) {
+		t.Fatalf("Stat(journal) error = %v, want removed", err)
+	}
+}
@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"strings"
+
+	"bond/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// newConfigCmd builds the command group that reads and writes config.yaml.
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Read and write global bond settings",
+		Long:  "Config manages config.yaml in the default store directory. Effective values follow flag > environment > config.yaml > default.",
+	}
+
+	cmd.AddCommand(newConfigGetCmd())
+	cmd.AddCommand(newConfigSetCmd())
+	cmd.AddCommand(newConfigListCmd())
+	return cmd
+}
+
+// newConfigGetCmd builds the command that prints one effective setting.
+func newConfigGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:               "get <key>",
+		Short:             "Print the effective value of a setting",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeConfigKeys,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resolved, err := config.ResolveSetting(args[0])
+			if err != nil {
+				return err
+			}
+			return printResult(cmd, levelInfo, resolved, "%s", resolved.Value)
+		},
+	}
+}
+
+// newConfigSetCmd builds the command that writes one setting to config.yaml.
+func newConfigSetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:               "set <key> <value>",
+		Short:             "Write a setting to config.yaml (an empty value unsets it)",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeConfigKeys,
+		Annotations:       dryRunAnnotations(dryRunUnsupported),
+		RunE:              runConfigSet,
+	}
+}
+
+// newConfigListCmd builds the command that prints every effective setting.
+func newConfigListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List effective settings and where each value comes from",
+		Args:  cobra.NoArgs,
+		RunE:  runConfigList,
+	}
+}
+
+// runConfigSet validates and stores one setting.
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	key, value := args[0], strings.TrimSpace(args[1])
+
+	path, err := config.SettingsFile()
+	if err != nil {
+		return err
+	}
+	settings, err := config.LoadSettingsFrom(path)
+	if err != nil {
+		return err
+	}
+	if err := settings.Set(key, value); err != nil {
+		return err
+	}
+	if err := config.SaveSettings(path, settings); err != nil {
+		return err
+	}
+
+	stored, ok, err := settings.Get(key)
+	if err != nil {
+		return err
+	}
+	record := config.ResolvedSetting{Key: key, Value: stored, Source: config.SourceConfig}
+	if !ok {
+		return printResult(cmd, levelOK, record, "unset %s", key)
+	}
+	return printResult(cmd, levelOK, record, "set %s = %s", key, stored)
+}
+
+// runConfigList prints each setting with its effective value and source.
+func runConfigList(cmd *cobra.Command, args []string) error {
+	settings, err := config.LoadSettings()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range config.SettingKeys {
+		resolved, err := config.Resolve(settings, key)
+		if err != nil {
+			return err
+		}
+		if err := printResult(cmd, levelInfo, resolved, "%s = %s (%s)", key, resolved.Value, resolved.Source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// completeConfigKeys offers setting keys for the first argument.
+func completeConfigKeys(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return config.SettingKeys, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfigSetThenListShowsSources(t *testing.T) {
+	xdg := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	t.Setenv("BOND_STORES", "")
+	t.Setenv("BOND_SYNC_MODE", "")
+	t.Setenv("BOND_SKILLS_DIR", "")
+	t.Setenv("BOND_EDITOR", "")
+	t.Setenv("EDITOR", "")
+	t.Setenv("BOND_NO_LEVEL", "")
+	t.Setenv("BOND_NO_COLORS", "")
+	os.Unsetenv("BOND_NO_COLORS")
+
+	buf := &bytes.Buffer{}
+	cmd := newConfigCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"set", "sync_mode", "copy"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute(set) error = %v", err)
+	}
+	if got, want := buf.String(), "[OK] set sync_mode = copy\n"; got != want {
+		t.Fatalf("set output = %q, want %q", got, want)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(xdg, "bond", "config.yaml"))
+	if err != nil {
+		t.Fatalf("ReadFile(config.yaml) error = %v", err)
+	}
+	if !strings.Contains(string(raw), "sync_mode: copy\n") {
+		t.Fatalf("config.yaml = %q, want sync_mode", raw)
+	}
+
+	buf.Reset()
+	cmd = newConfigCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"list"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute(list) error = %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		"[INFO] sync_mode = copy (config)\n",
+		"[INFO] skills_dir = .agents/skills (default)\n",
+		"[INFO] stores = default=" + filepath.Join(xdg, "bond") + " (default)\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("list output missing %q: %q", want, output)
+		}
+	}
+
+	t.Setenv("BOND_SYNC_MODE", "link")
+	buf.Reset()
+	cmd = newConfigCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"get", "sync_mode"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute(get) error = %v", err)
+	}
+	if got, want := buf.String(), "[INFO] link\n"; got != want {
+		t.Fatalf("get output = %q, want env override %q", got, want)
+	}
+}
+
+func TestConfigSetRejectsUnknownKey(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cmd := newConfigCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"set", "colour", "never"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `unknown config key "colour"`) {
+		t.Fatalf("Execute() error = %v, want unknown key", err)
+	}
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"bond/internal/config"
 	"bond/internal/skills"
@@ -11,51 +12,116 @@ import (
 
 // newCopyCmd builds the command that copies store skills into the project.
 func newCopyCmd() *cobra.Command {
+	var opts batchOptions
+
 	cmd := &cobra.Command{
-		Use:   "copy [skill ...]",
-		Short: "Copy store skills into ./.agents/skills",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  runCopy,
+		Use:         "copy [selector ...]",
+		Short:       "Copy store skills into ./.agents/skills",
+		Long:        "Copy copies store skills into the project and records them in bond.lock. " + selectorHelp + " " + atomicHelp,
+		Annotations: dryRunAnnotations(dryRunSupported),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCopy(cmd, args, opts)
+		},
 	}
 
+	addBatchFlags(cmd, &opts)
 	cmd.ValidArgsFunction = completeStoreSkills
 	return cmd
 }
 
 // runCopy executes copy operations and prints per-skill status.
-func runCopy(cmd *cobra.Command, args []string) error {
-	sourceDir, err := config.StoreSkillsDir()
+func runCopy(cmd *cobra.Command, args []string, opts batchOptions) error {
+	stores, err := configuredStores()
 	if err != nil {
 		return err
 	}
 
-	skillsDir, err := config.ProjectSkillsDir()
+	targets, err := projectTargets()
 	if err != nil {
 		return err
 	}
-	if _, err := ensureDir(skillsDir); err != nil {
+	for _, target := range targets {
+		if err := prepareDir(cmd, target.dir); err != nil {
+			return err
+		}
+	}
+
+	discovered, err := discoverStoreSkills(stores)
+	if err != nil {
 		return err
 	}
 
-	discovered, err := skills.Discover(sourceDir)
+	lockPath, err := config.ProjectLockFile()
+	if err != nil {
+		return err
+	}
+	lock, err := skills.LoadLockfile(lockPath)
+	if err != nil {
+		return err
+	}
+	baseDir, err := config.ProjectBaseDir()
 	if err != nil {
 		return err
 	}
 
-	return runDiscoveredSkillActions(cmd, discovered, args, func(skill skills.Skill) (skillActionOutput, error) {
-		dest := filepath.Join(skillsDir, skill.Name)
-		result, err := skills.Copy(skill.Path, dest)
+	recorded := false
+	// An atomic batch can still roll back after an action's commit, so its
+	// merge-base snapshots are written once the whole batch is in place.
+	var snapshots []func() error
+	runErr := runTargetSkillActions(cmd, discovered, args, opts, targets, func(skill skills.Skill, target projectTarget) (skillActionOutput, error) {
+		dest := filepath.Join(target.dir, skill.Name)
+		result, ops, err := copySkill(skill.Path, dest)
 		if err != nil {
 			return skillActionOutput{}, err
 		}
 
 		switch result.Status {
 		case skills.CopyStatusCopied:
-			return skillActionOutput{level: levelOK, message: fmt.Sprintf("copied %s", skill.Name)}, nil
+			output := skillActionOutput{level: levelOK, message: fmt.Sprintf("copied %s", skill.Name), status: string(result.Status), path: dest, ops: ops}
+			// Provenance follows the primary target, where update, diff and store look.
+			if target.dir == targets[0].dir {
+				output.commit = func() error {
+					if opts.atomic {
+						if err := lock.RecordCopy(skill.Name, skill.Path, dest, time.Now()); err != nil {
+							return err
+						}
+						snapshots = append(snapshots, func() error { return skills.SnapshotBase(dest, baseDir, skill.Name) })
+					} else if err := recordCopy(&lock, baseDir, skill.Name, skill.Path, dest); err != nil {
+						return err
+					}
+					recorded = true
+					return nil
+				}
+			}
+			return output, nil
 		case skills.CopyStatusConflict:
-			return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (already exists)", skill.Name)}, nil
+			return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (already exists)", skill.Name), status: string(result.Status), path: dest}, nil
 		default:
 			return skillActionOutput{}, fmt.Errorf("unexpected copy status %q for %q", result.Status, skill.Name)
 		}
 	})
+
+	// A rolled-back atomic batch leaves no copies to record.
+	if recorded && !(opts.atomic && runErr != nil) {
+		if err := skills.SaveLockfile(lockPath, lock); err != nil {
+			return err
+		}
+		for _, snapshot := range snapshots {
+			if err := snapshot(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := registerProject(); err != nil {
+		return err
+	}
+	return runErr
+}
+
+// recordCopy stores lock provenance and a merge-base snapshot for a project copy.
+func recordCopy(lock *skills.Lockfile, baseDir, name, sourcePath, destPath string) error {
+	if err := lock.RecordCopy(name, sourcePath, destPath, time.Now()); err != nil {
+		return err
+	}
+	return skills.SnapshotBase(destPath, baseDir, name)
 }
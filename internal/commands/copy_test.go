@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"bond/internal/skills"
 )
 
 func TestCopyCommandRequiresAtLeastOneSkillArg(t *testing.T) {
@@ -78,6 +80,21 @@ func TestCopyCommandCopiesAndThenSkipsExisting(t *testing.T) {
 		t.Fatalf("Stat(copied nested file) error = %v", err)
 	}
 
+	lock, err := skills.LoadLockfile(filepath.Join(projectRoot, "bond.lock"))
+	if err != nil {
+		t.Fatalf("LoadLockfile() error = %v", err)
+	}
+	entry, ok := lock.Skills["go"]
+	if !ok {
+		t.Fatalf("lock.Skills = %#v, want go entry", lock.Skills)
+	}
+	if entry.Source != globalSkill {
+		t.Fatalf("lock source = %q, want %q", entry.Source, globalSkill)
+	}
+	if digest, err := skills.DigestDir(projectSkill); err != nil || digest != entry.Digest {
+		t.Fatalf("lock digest = %q, want %q (err = %v)", entry.Digest, digest, err)
+	}
+
 	buf.Reset()
 	cmd = newCopyCmd()
 	cmd.SetOut(buf)
@@ -19,9 +19,10 @@ func newCreateCmd() *cobra.Command {
 	var description string
 
 	cmd := &cobra.Command{
-		Use:   "create <name>",
-		Short: "Create a new skill scaffold in the store directory",
-		Args:  cobra.ExactArgs(1),
+		Use:         "create <name>",
+		Short:       "Create a new skill scaffold in the store directory",
+		Args:        cobra.ExactArgs(1),
+		Annotations: dryRunAnnotations(dryRunSupported),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runCreate(cmd, args[0], description, cmd.Flags().Changed("description"))
 		},
@@ -33,7 +34,7 @@ func newCreateCmd() *cobra.Command {
 
 // runCreate creates one new skill directory in the store with a starter SKILL.md.
 func runCreate(cmd *cobra.Command, name, description string, descriptionProvided bool) error {
-	if err := validateCreateSkillName(name); err != nil {
+	if err := validateNewSkillName(name); err != nil {
 		return err
 	}
 
@@ -41,7 +42,7 @@ func runCreate(cmd *cobra.Command, name, description string, descriptionProvided
 	if err != nil {
 		return err
 	}
-	if _, err := ensureDir(storeDir); err != nil {
+	if err := prepareDir(cmd, storeDir); err != nil {
 		return err
 	}
 
@@ -52,10 +53,6 @@ func runCreate(cmd *cobra.Command, name, description string, descriptionProvided
 		return err
 	}
 
-	if err := os.MkdirAll(skillDir, 0o755); err != nil {
-		return err
-	}
-
 	needsDescriptionWarning := !descriptionProvided || strings.TrimSpace(description) == ""
 	if strings.TrimSpace(description) == "" {
 		description = defaultCreateDescription
@@ -63,12 +60,21 @@ func runCreate(cmd *cobra.Command, name, description string, descriptionProvided
 
 	skillFile := filepath.Join(skillDir, "SKILL.md")
 	contents := fmt.Sprintf("---\nname: %s\ndescription: %s\n---\n", name, strconv.Quote(description))
-	if err := os.WriteFile(skillFile, []byte(contents), 0o644); err != nil {
-		return err
-	}
-
-	if err := printOut(cmd, levelOK, "created %s", name); err != nil {
-		return err
+	if dryRun {
+		ops := []skills.Operation{{Kind: skills.OpMkdir, Path: skillDir}, {Kind: skills.OpWriteFile, Path: skillFile}}
+		if err := printOut(cmd, levelOK, "created %s%s", name, dryRunSuffix(ops)); err != nil {
+			return err
+		}
+	} else {
+		if err := os.MkdirAll(skillDir, 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(skillFile, []byte(contents), 0o644); err != nil {
+			return err
+		}
+		if err := printOut(cmd, levelOK, "created %s", name); err != nil {
+			return err
+		}
 	}
 	if needsDescriptionWarning {
 		return printOut(cmd, levelWarn, "add a description that describes the skill")
@@ -76,7 +82,8 @@ func runCreate(cmd *cobra.Command, name, description string, descriptionProvided
 	return nil
 }
 
-func validateCreateSkillName(name string) error {
+// validateNewSkillName checks a name for a skill being created or renamed.
+func validateNewSkillName(name string) error {
 	nameCheck := skills.CheckSkillName(name)
 	if nameCheck.Empty {
 		return fmt.Errorf("skill name must not be empty")
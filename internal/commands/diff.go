@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// newDiffCmd builds the command that compares project copies with store originals.
+func newDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [skill]",
+		Short: "Show differences between copied project skills and the store",
+		Long:  "Diff compares ./.agents/skills copies against the store directory. Files only in the project are reported as added and files only in the store as removed.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runDiff,
+	}
+
+	cmd.ValidArgsFunction = completeProjectStorableSkills
+	return cmd
+}
+
+// runDiff prints file-level changes and unified diffs for project copies.
+func runDiff(cmd *cobra.Command, args []string) error {
+	projectSkillsDir, err := primarySkillsDir()
+	if err != nil {
+		return err
+	}
+
+	stores, err := configuredStores()
+	if err != nil {
+		return err
+	}
+
+	copies, err := skills.DiscoverProjectStorable(projectSkillsDir)
+	if err != nil {
+		return err
+	}
+	storeByName, err := visibleStoreSkills(stores)
+	if err != nil {
+		return err
+	}
+	lockPath, err := config.ProjectLockFile()
+	if err != nil {
+		return err
+	}
+	lock, err := skills.LoadLockfile(lockPath)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		selected, err := selectOneSkill(copies, args[0], fmt.Errorf("no matching skills: %s", args[0]))
+		if err != nil {
+			return err
+		}
+		if _, ok, err := copySource(storeByName, lock, selected.Name); err != nil {
+			return err
+		} else if !ok {
+			return fmt.Errorf("%s: %w", selected.Name, errCopySourceMissing(stores, lock, selected.Name))
+		}
+		copies = []skills.Skill{selected}
+	}
+
+	for _, skill := range copies {
+		storeSkill, ok, err := copySource(storeByName, lock, skill.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		changes, err := skills.DiffTrees(storeSkill.Path, skill.Path, "store/"+skill.Name, "project/"+skill.Name)
+		if err != nil {
+			return err
+		}
+		if err := printSkillDiff(cmd, skill.Name, changes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skillDiffRecord is the structured form of one skill's diff.
+type skillDiffRecord struct {
+	Name    string              `json:"name"`
+	Changes []skills.FileChange `json:"changes"`
+}
+
+// printSkillDiff writes one status line per changed file followed by its unified diff.
+func printSkillDiff(cmd *cobra.Command, name string, changes []skills.FileChange) error {
+	if structuredOutput() {
+		if changes == nil {
+			changes = []skills.FileChange{}
+		}
+		return writeRecord(cmd.OutOrStdout(), skillDiffRecord{Name: name, Changes: changes})
+	}
+
+	if len(changes) == 0 {
+		return printOut(cmd, levelOK, "unchanged %s", name)
+	}
+
+	for _, change := range changes {
+		if change.Binary {
+			if err := printOut(cmd, levelWarn, "%s %s/%s (binary)", change.Kind, name, change.Path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := printOut(cmd, levelWarn, "%s %s/%s", change.Kind, name, change.Path); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(cmd.OutOrStdout(), change.Diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiffCommandReportsChangedFiles(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	projectSkills := filepath.Join(projectRoot, ".agents", "skills")
+	xdgConfig := filepath.Join(tmp, "xdg")
+	storeDir := filepath.Join(xdgConfig, "bond")
+
+	for _, dir := range []string{
+		filepath.Join(storeDir, "go"),
+		filepath.Join(storeDir, "react"),
+		filepath.Join(projectSkills, "go"),
+		filepath.Join(projectSkills, "react"),
+	} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("MkdirAll(%s) error = %v", dir, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("line one\nline two\n"), 0o644); err != nil {
+			t.Fatalf("WriteFile(%s/SKILL.md) error = %v", dir, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(projectSkills, "go", "SKILL.md"), []byte("line one\nline 2\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(project go SKILL.md) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectSkills, "go", "notes.md"), []byte("notes\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(notes.md) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", xdgConfig)
+
+	buf := &bytes.Buffer{}
+	cmd := newDiffCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{
+		"[WARN] modified go/SKILL.md\n--- store/go/SKILL.md\n+++ project/go/SKILL.md\n@@ -1,2 +1,2 @@\n line one\n-line two\n+line 2\n",
+		"[WARN] added go/notes.md\n--- /dev/null\n+++ project/go/notes.md\n",
+		"[OK] unchanged react\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestDiffCommandRequiresProjectCopy(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+
+	cmd := newDiffCmd()
+	cmd.SetArgs([]string{"missing"})
+
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatal("Execute() error = nil, want no matching skills error")
+	}
+	if !strings.Contains(err.Error(), "no matching skills: missing") {
+		t.Fatalf("Execute() error = %q, want no matching skills message", err)
+	}
+}
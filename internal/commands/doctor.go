@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"fmt"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// newDoctorCmd builds the command that checks stores and project skills for problems.
+func newDoctorCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check stores and project skills for problems",
+		Long:  "Doctor checks that every store exists and is accessible, and looks for duplicate skill names, broken or external links, entries in the project skills directories that are not skills, leftovers of interrupted copies, and invalid SKILL.md files. It fails when it finds errors. Problems marked with a repair are fixed by bond repair.",
+		Args:  cobra.NoArgs,
+		RunE:  runDoctor,
+	}
+}
+
+// runDoctor prints every finding and fails when one of them is an error.
+func runDoctor(cmd *cobra.Command, args []string) error {
+	findings, err := collectFindings()
+	if err != nil {
+		return err
+	}
+
+	if len(findings) == 0 && !structuredOutput() {
+		return printOut(cmd, levelOK, "no problems found")
+	}
+
+	errorCount := 0
+	repairable := 0
+	for _, finding := range findings {
+		if finding.Severity == skills.SeverityError {
+			errorCount++
+		}
+		if len(finding.Repair) > 0 {
+			repairable++
+		}
+		if err := printResult(cmd, findingLevel(finding), finding, "%s %s%s", finding.Kind, finding.Message, findingSuffix(finding)); err != nil {
+			return err
+		}
+	}
+
+	if !structuredOutput() && repairable > 0 {
+		if err := printOut(cmd, levelInfo, "bond repair can fix %d of %d problems", repairable, len(findings)); err != nil {
+			return err
+		}
+	}
+	if errorCount > 0 {
+		return alreadyReportedFailure()
+	}
+	return nil
+}
+
+// repairRecord is the structured form of one repair attempt.
+type repairRecord struct {
+	Kind       skills.FindingKind `json:"kind"`
+	Path       string             `json:"path"`
+	Message    string             `json:"message"`
+	Status     string             `json:"status"`
+	Level      string             `json:"level"`
+	Operations []skills.Operation `json:"operations,omitempty"`
+	Hint       string             `json:"hint,omitempty"`
+	Error      string             `json:"error,omitempty"`
+	DryRun     bool               `json:"dry_run,omitempty"`
+}
+
+// newRepairCmd builds the command that fixes problems found by doctor.
+func newRepairCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "repair",
+		Short:       "Fix the problems bond doctor can repair",
+		Long:        "Repair relinks broken links to the store skill of the same name, removes broken links with no such skill, deletes leftovers of interrupted copies, and moves a skill an interrupted replace set aside back into place when nothing took its place. Problems that need a decision are listed with a hint and left alone. Use --dry-run to preview the fixes; relinks and link removals can be reversed with bond undo.",
+		Args:        cobra.NoArgs,
+		Annotations: dryRunAnnotations(dryRunSupported),
+		RunE:        runRepair,
+	}
+}
+
+// runRepair applies the repair of every finding that has one.
+func runRepair(cmd *cobra.Command, args []string) error {
+	findings, err := collectFindings()
+	if err != nil {
+		return err
+	}
+	if len(findings) == 0 && !structuredOutput() {
+		return printOut(cmd, levelOK, "nothing to repair")
+	}
+
+	failures := 0
+	for _, finding := range findings {
+		record := repairRecord{Kind: finding.Kind, Path: finding.Path, Message: finding.Message, Operations: finding.Repair, Hint: finding.Hint, DryRun: dryRun}
+
+		if len(finding.Repair) == 0 {
+			record.Status = "skipped"
+			record.Level = levelWarn
+			if err := printResult(cmd, levelWarn, record, "skipped %s%s", finding.Message, findingSuffix(finding)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if dryRun {
+			record.Status = "repaired"
+			record.Level = levelOK
+			if err := printResult(cmd, levelOK, record, "repaired %s%s", finding.Message, dryRunSuffix(finding.Repair)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := applyOperations(finding.Repair); err != nil {
+			failures++
+			record.Status = "failed"
+			record.Level = levelError
+			record.Error = err.Error()
+			if err := printResult(cmd, levelError, record, "could not repair %s: %v", finding.Message, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		record.Status = "repaired"
+		record.Level = levelOK
+		if err := printResult(cmd, levelOK, record, "repaired %s", finding.Message); err != nil {
+			return err
+		}
+	}
+
+	if failures > 0 {
+		return alreadyReportedFailure()
+	}
+	return nil
+}
+
+// collectFindings runs the store checks and the project checks for every target.
+func collectFindings() ([]skills.Finding, error) {
+	stores, err := configuredStores()
+	if err != nil {
+		return nil, err
+	}
+	findings, err := skills.CheckStores(stores)
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := projectTargets()
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range targets {
+		projectFindings, err := skills.CheckProject(stores, target.dir)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, projectFindings...)
+	}
+
+	// Replacing a merge-base snapshot stages copies in the base directory too.
+	baseDir, err := config.ProjectBaseDir()
+	if err != nil {
+		return nil, err
+	}
+	leftovers, err := skills.FindInterruptedCopies(baseDir)
+	if err != nil {
+		return nil, err
+	}
+	return append(findings, leftovers...), nil
+}
+
+// findingLevel maps a finding severity to an output level.
+func findingLevel(finding skills.Finding) string {
+	if finding.Severity == skills.SeverityError {
+		return levelError
+	}
+	return levelWarn
+}
+
+// findingSuffix describes the repair or hint for a finding.
+func findingSuffix(finding skills.Finding) string {
+	if len(finding.Repair) > 0 {
+		return fmt.Sprintf(" (repair: %s)", describeOperations(finding.Repair))
+	}
+	if finding.Hint != "" {
+		return fmt.Sprintf(" (%s)", finding.Hint)
+	}
+	return ""
+}
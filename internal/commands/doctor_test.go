@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDoctorReportsBrokenLinkAndRepairRelinksIt(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("---\nname: go\ndescription: Go\n---\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	link := filepath.Join(projectRoot, ".agents", "skills", "go")
+	missing := filepath.Join(projectRoot, "moved", "go")
+	if err := os.Symlink(missing, link); err != nil {
+		t.Fatalf("Symlink() error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "doctor")
+	if !IsAlreadyReportedFailure(err) {
+		t.Fatalf("Execute(doctor) error = %v, want already-reported failure", err)
+	}
+	want := "[ERROR] broken_link go links to missing " + missing + " (repair: remove link " + link + "; symlink " + link + " -> " + storeSkill + ")\n" +
+		"[INFO] bond repair can fix 1 of 1 problems\n"
+	if output != want {
+		t.Fatalf("doctor output = %q, want %q", output, want)
+	}
+
+	output, err = executeRootForTest(t, "--dry-run", "repair")
+	if err != nil {
+		t.Fatalf("Execute(repair --dry-run) error = %v", err)
+	}
+	if !strings.HasPrefix(output, "[OK] repaired go links to missing "+missing+" (dry run: ") {
+		t.Fatalf("repair --dry-run output = %q", output)
+	}
+	if target, _ := os.Readlink(link); target != missing {
+		t.Fatalf("Readlink() = %q after dry run, want unchanged", target)
+	}
+
+	if _, err := executeRootForTest(t, "repair"); err != nil {
+		t.Fatalf("Execute(repair) error = %v", err)
+	}
+	if target, err := os.Readlink(link); err != nil || target != storeSkill {
+		t.Fatalf("Readlink() = %q, %v, want %q", target, err, storeSkill)
+	}
+
+	output, err = executeRootForTest(t, "doctor")
+	if err != nil || output != "[OK] no problems found\n" {
+		t.Fatalf("Execute(doctor) = %q, %v, want no problems", output, err)
+	}
+}
@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// annotationDryRun marks how a mutating command treats --dry-run. Commands
+// without it only read state and ignore the flag.
+const annotationDryRun = "bond.dry-run"
+
+const (
+	dryRunSupported   = "supported"
+	dryRunUnsupported = "unsupported"
+)
+
+// dryRun makes mutating commands report planned operations instead of applying them.
+var dryRun bool
+
+// setDryRun switches dry-run mode for subsequent commands.
+func setDryRun(enabled bool) {
+	dryRun = enabled
+}
+
+// checkDryRunSupport rejects --dry-run for mutating commands that cannot preview their changes.
+func checkDryRunSupport(cmd *cobra.Command) error {
+	if !dryRun || cmd.Annotations[annotationDryRun] != dryRunUnsupported {
+		return nil
+	}
+	return fmt.Errorf("bond %s does not support --dry-run", cmd.Name())
+}
+
+// dryRunAnnotations returns command annotations declaring dry-run support.
+func dryRunAnnotations(support string) map[string]string {
+	return map[string]string{annotationDryRun: support}
+}
+
+// dryRunSuffix describes ops for a dry-run message.
+func dryRunSuffix(ops []skills.Operation) string {
+	if len(ops) == 0 {
+		return " (dry run)"
+	}
+	parts := make([]string, 0, len(ops))
+	for _, op := range ops {
+		parts = append(parts, op.String())
+	}
+	return " (dry run: " + strings.Join(parts, "; ") + ")"
+}
+
+// planDir reports the operation needed to create path, if any.
+func planDir(path string) ([]skills.Operation, error) {
+	if info, err := os.Stat(path); err == nil {
+		if info.IsDir() {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%q exists and is not a directory", path)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	return []skills.Operation{{Kind: skills.OpMkdir, Path: path}}, nil
+}
+
+// prepareDir creates path when missing. In dry-run mode it reports the
+// directory it would create instead.
+func prepareDir(cmd *cobra.Command, path string) error {
+	if !dryRun {
+		_, err := ensureDir(path)
+		return err
+	}
+
+	ops, err := planDir(path)
+	if err != nil || len(ops) == 0 {
+		return err
+	}
+	return printPlannedOps(cmd, ops)
+}
+
+// plannedOpsRecord is the structured form of operations a dry run would perform.
+type plannedOpsRecord struct {
+	DryRun     bool               `json:"dry_run"`
+	Operations []skills.Operation `json:"operations"`
+}
+
+// printPlannedOps reports operations that are not tied to one skill.
+func printPlannedOps(cmd *cobra.Command, ops []skills.Operation) error {
+	if structuredOutput() {
+		return writeRecord(cmd.OutOrStdout(), plannedOpsRecord{DryRun: true, Operations: ops})
+	}
+	for _, op := range ops {
+		if err := printOut(cmd, levelInfo, "dry run: %s", op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkSkill plans linking sourcePath at destPath and applies the plan unless previewing.
+func linkSkill(sourcePath, destPath string) (skills.LinkResult, []skills.Operation, error) {
+	result, ops, err := skills.PlanLink(sourcePath, destPath)
+	if err != nil || previewing() {
+		return result, ops, err
+	}
+	return result, ops, applyOperations(ops)
+}
+
+// copySkill plans copying sourcePath to destPath and applies the plan unless previewing.
+func copySkill(sourcePath, destPath string) (skills.CopyResult, []skills.Operation, error) {
+	result, ops, err := skills.PlanCopy(sourcePath, destPath)
+	if err != nil || previewing() {
+		return result, ops, err
+	}
+	return result, ops, applyOperations(ops)
+}
+
+// unlinkSkill plans removing the symlink at path and applies the plan unless previewing.
+func unlinkSkill(path string) (bool, []skills.Operation, error) {
+	removed, ops, err := skills.PlanUnlink(path)
+	if err != nil || previewing() {
+		return removed, ops, err
+	}
+	return removed, ops, applyOperations(ops)
+}
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bond/internal/skills"
+)
+
+func TestDryRunLinkReportsSymlinkWithoutCreatingIt(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	dest := filepath.Join(projectRoot, ".agents", "skills", "go")
+
+	output, err := executeRootForTest(t, "--dry-run", "link", "go")
+	if err != nil {
+		t.Fatalf("Execute(link --dry-run) error = %v", err)
+	}
+	if want := "[OK] linked go (dry run: symlink " + dest + " -> " + storeSkill + ")\n"; output != want {
+		t.Fatalf("output = %q, want %q", output, want)
+	}
+	if _, err := os.Lstat(dest); !os.IsNotExist(err) {
+		t.Fatalf("Lstat(dest) error = %v, want not exist", err)
+	}
+}
+
+func TestDryRunCopyAndUnlinkLeaveProjectUnchanged(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	skillsDir := filepath.Join(projectRoot, ".agents", "skills")
+
+	output, err := executeRootForTest(t, "--dry-run", "copy", "go")
+	if err != nil {
+		t.Fatalf("Execute(copy --dry-run) error = %v", err)
+	}
+	if want := "[OK] copied go (dry run: copy tree " + storeSkill + " -> " + filepath.Join(skillsDir, "go") + ")\n"; output != want {
+		t.Fatalf("copy output = %q, want %q", output, want)
+	}
+	if _, err := os.Stat(filepath.Join(projectRoot, "bond.lock")); !os.IsNotExist(err) {
+		t.Fatalf("Stat(bond.lock) error = %v, want not exist", err)
+	}
+
+	if err := os.Symlink(storeSkill, filepath.Join(skillsDir, "go")); err != nil {
+		t.Fatalf("Symlink() error = %v", err)
+	}
+	output, err = executeRootForTest(t, "--dry-run", "unlink", "go")
+	if err != nil {
+		t.Fatalf("Execute(unlink --dry-run) error = %v", err)
+	}
+	if want := "[OK] unlinked go (dry run: remove link " + filepath.Join(skillsDir, "go") + ")\n"; output != want {
+		t.Fatalf("unlink output = %q, want %q", output, want)
+	}
+	if _, err := os.Readlink(filepath.Join(skillsDir, "go")); err != nil {
+		t.Fatalf("Readlink() error = %v, want link kept", err)
+	}
+}
+
+func TestDryRunCreateAndInitDoNotWrite(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	if err := os.RemoveAll(filepath.Join(projectRoot, ".agents")); err != nil {
+		t.Fatalf("RemoveAll(.agents) error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "--dry-run", "create", "rust")
+	if err != nil {
+		t.Fatalf("Execute(create --dry-run) error = %v", err)
+	}
+	newSkill := filepath.Join(filepath.Dir(storeSkill), "rust")
+	if !strings.HasPrefix(output, "[OK] created rust (dry run: create directory "+newSkill) {
+		t.Fatalf("create output = %q", output)
+	}
+	if _, err := os.Stat(newSkill); !os.IsNotExist(err) {
+		t.Fatalf("Stat(new skill) error = %v, want not exist", err)
+	}
+
+	output, err = executeRootForTest(t, "--dry-run", "init")
+	if err != nil {
+		t.Fatalf("Execute(init --dry-run) error = %v", err)
+	}
+	agentsDir := filepath.Join(projectRoot, ".agents")
+	want := "[OK] initialized .agents/skills (dry run: create directory " + agentsDir + "; create directory " + filepath.Join(agentsDir, "skills") + ")\n"
+	if output != want {
+		t.Fatalf("init output = %q, want %q", output, want)
+	}
+	if _, err := os.Stat(agentsDir); !os.IsNotExist(err) {
+		t.Fatalf("Stat(.agents) error = %v, want not exist", err)
+	}
+}
+
+func TestDryRunStructuredRecordsListOperations(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	withOutputFormat(t, outputFormatJSON)
+
+	output, err := executeRootForTest(t, "--dry-run", "--output", "json", "link", "go")
+	if err != nil {
+		t.Fatalf("Execute(link --dry-run) error = %v", err)
+	}
+	var records []skillActionRecord
+	if err := json.Unmarshal([]byte(output), &records); err != nil {
+		t.Fatalf("Unmarshal(%q) error = %v", output, err)
+	}
+	if len(records) != 1 || !records[0].DryRun || records[0].Status != "linked" {
+		t.Fatalf("records = %+v, want one dry-run linked record", records)
+	}
+	want := []skills.Operation{{Kind: skills.OpSymlink, Path: filepath.Join(projectRoot, ".agents", "skills", "go"), Source: storeSkill}}
+	if got := records[0].Operations; len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("operations = %+v, want %+v", got, want)
+	}
+}
+
+func TestDryRunRejectsUnsupportedCommands(t *testing.T) {
+	setupGoSkillProject(t)
+
+	_, err := executeRootForTest(t, "--dry-run", "sync")
+	if err == nil || err.Error() != "bond sync does not support --dry-run" {
+		t.Fatalf("Execute(sync --dry-run) error = %v, want unsupported error", err)
+	}
+}
@@ -2,22 +2,20 @@ package commands
 
 import (
 	"fmt"
-	"os"
 	"os/exec"
 	"path/filepath"
-	"strings"
 
 	"bond/internal/config"
-	"bond/internal/skills"
 	"github.com/spf13/cobra"
 )
 
 // newEditCmd builds the command that opens a store skill in the configured editor.
 func newEditCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "edit <skill>",
-		Short: "Open a store skill SKILL.md in $EDITOR",
-		Args:  cobra.ExactArgs(1),
+		Use:         "edit <skill>",
+		Short:       "Open a store skill SKILL.md in your editor",
+		Args:        cobra.ExactArgs(1),
+		Annotations: dryRunAnnotations(dryRunUnsupported),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runEdit(cmd, args[0])
 		},
@@ -29,29 +27,33 @@ func newEditCmd() *cobra.Command {
 
 // runEdit resolves one store skill and opens its SKILL.md in the configured editor.
 func runEdit(cmd *cobra.Command, name string) error {
-	editor := strings.TrimSpace(os.Getenv("EDITOR"))
+	resolved, err := config.ResolveSetting(config.KeyEditor)
+	if err != nil {
+		return err
+	}
+	editor := resolved.Value
 	if editor == "" {
-		return fmt.Errorf("EDITOR environment variable is not set")
+		return fmt.Errorf("EDITOR environment variable is not set (or set BOND_EDITOR, or run bond config set editor <command>)")
 	}
 
-	storeDir, err := config.StoreSkillsDir()
+	stores, err := configuredStores()
 	if err != nil {
 		return err
 	}
 
-	discovered, err := skills.Discover(storeDir)
+	discovered, err := discoverStoreSkills(stores)
 	if err != nil {
 		return err
 	}
 
-	selected := selectSkills(discovered, []string{name})
-	if len(selected) == 0 {
-		return fmt.Errorf("no matching skills: %s", name)
+	selected, err := selectOneSkill(discovered, name, fmt.Errorf("no matching skills: %s", name))
+	if err != nil {
+		return err
 	}
 
-	skillFile := filepath.Join(selected[0].Path, "SKILL.md")
+	skillFile := filepath.Join(selected.Path, "SKILL.md")
 
-	// Use shell parsing so EDITOR values like "code -w" work as expected.
+	// Use shell parsing so editor values like "code -w" work as expected.
 	editCmd := exec.Command("sh", "-c", editor+" \"$1\"", "bond-edit", skillFile)
 	editCmd.Stdin = cmd.InOrStdin()
 	editCmd.Stdout = cmd.OutOrStdout()
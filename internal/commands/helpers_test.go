@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func chdirForTest(t *testing.T, dir string) {
+	t.Helper()
+
+	prevWd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("os.Getwd() error = %v", err)
+	}
+	t.Cleanup(func() {
+		if chdirErr := os.Chdir(prevWd); chdirErr != nil {
+			t.Fatalf("restore cwd error = %v", chdirErr)
+		}
+	})
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("os.Chdir() error = %v", err)
+	}
+}
+
+// setupGoSkillProject creates a store skill "go" and an initialized project.
+func setupGoSkillProject(t *testing.T) (storeSkill, projectRoot string) {
+	t.Helper()
+	withOutputColorMode(t, colorModeNever)
+	withOutputShowLevel(t, true)
+	t.Cleanup(func() { setDryRun(false) })
+
+	tmp := t.TempDir()
+	xdg := filepath.Join(tmp, "xdg")
+	storeSkill = filepath.Join(xdg, "bond", "go")
+	projectRoot = filepath.Join(tmp, "project")
+	mustMkdirAll(t, storeSkill)
+	mustMkdirAll(t, filepath.Join(projectRoot, ".agents", "skills"))
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	t.Setenv("BOND_SKILLS_DIR", "")
+	chdirForTest(t, projectRoot)
+	return storeSkill, projectRoot
+}
+
+func mustMkdirAll(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatalf("MkdirAll(%q) error = %v", path, err)
+	}
+}
+
+func executeRootForTest(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	cmd := newRootCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs(args)
+	err := executeRoot(cmd)
+	return buf.String(), err
+}
+
+func setupCopiedSkillForUpdate(t *testing.T) (projectRoot, storeSkill, projectSkill string) {
+	t.Helper()
+
+	tmp := t.TempDir()
+	projectRoot = filepath.Join(tmp, "project")
+	xdgConfig := filepath.Join(tmp, "xdg")
+	storeSkill = filepath.Join(xdgConfig, "bond", "go")
+	projectSkill = filepath.Join(projectRoot, ".agents", "skills", "go")
+
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	if err := os.MkdirAll(storeSkill, 0o755); err != nil {
+		t.Fatalf("MkdirAll(storeSkill) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("v1"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", xdgConfig)
+
+	cmd := newCopyCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"go"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("copy Execute() error = %v", err)
+	}
+	return projectRoot, storeSkill, projectSkill
+}
+
+func executeUpdateForTest(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	cmd := newUpdateCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return buf.String(), err
+}
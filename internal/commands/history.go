@@ -0,0 +1,297 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// historyCommand is the command line recorded with the operations it applies.
+var historyCommand string
+
+// appliedOps collects the operations the running command applied, written to
+// the project history once it finishes.
+var appliedOps []skills.Operation
+
+// startHistory begins collecting operations for cmd invoked with args.
+func startHistory(cmd *cobra.Command, args []string) {
+	words := append([]string{cmd.CommandPath()}, changedFlags(cmd)...)
+	historyCommand = strings.Join(append(words, args...), " ")
+	appliedOps = nil
+}
+
+// changedFlags renders the flags set on the command line, in name order, so
+// history tells apart runs such as bond unlink and bond unlink --all --atomic.
+func changedFlags(cmd *cobra.Command) []string {
+	words := []string{}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if slice, ok := f.Value.(pflag.SliceValue); ok {
+			for _, item := range slice.GetSlice() {
+				words = append(words, "--"+f.Name+"="+item)
+			}
+			return
+		}
+		if f.Value.Type() == "bool" && f.Value.String() == "true" {
+			words = append(words, "--"+f.Name)
+			return
+		}
+		words = append(words, "--"+f.Name+"="+f.Value.String())
+	})
+	return words
+}
+
+// applyOperations applies ops in order and records each applied one for bond history.
+func applyOperations(ops []skills.Operation) error {
+	for _, op := range ops {
+		applied, err := skills.ApplyRecorded(op)
+		if err != nil {
+			return err
+		}
+		recordApplied([]skills.Operation{applied})
+	}
+	return nil
+}
+
+// recordApplied records applied ops for bond history. Operations undo cannot
+// reverse are left out.
+func recordApplied(ops []skills.Operation) {
+	for _, op := range ops {
+		if op.Reversible() {
+			appliedOps = append(appliedOps, op)
+		}
+	}
+}
+
+// flushHistory appends the operations the command applied, if any, to the
+// project history. It runs even when the command failed partway.
+func flushHistory() error {
+	if len(appliedOps) == 0 {
+		return nil
+	}
+	ops := appliedOps
+	appliedOps = nil
+
+	path, err := config.ProjectHistoryFile()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	history, err := skills.LoadHistory(path)
+	if err != nil {
+		return err
+	}
+	history.Append(historyCommand, time.Now(), ops)
+	return skills.SaveHistory(path, history)
+}
+
+// newHistoryCmd builds the command that lists recorded operations.
+func newHistoryCmd() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List the operations bond applied in this project, newest first",
+		Long:  "History lists the links, copies and link removals recorded by link, copy, unlink, store and sync, newest first. Use bond undo to reverse them.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHistory(cmd, limit)
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 20, "Show at most this many entries (0 for all)")
+	return cmd
+}
+
+// runHistory prints history entries, newest first.
+func runHistory(cmd *cobra.Command, limit int) error {
+	path, err := config.ProjectHistoryFile()
+	if err != nil {
+		return err
+	}
+	history, err := skills.LoadHistory(path)
+	if err != nil {
+		return err
+	}
+
+	if len(history.Entries) == 0 && !structuredOutput() {
+		return printOut(cmd, levelInfo, "no recorded operations")
+	}
+
+	shown := 0
+	for i := len(history.Entries) - 1; i >= 0; i-- {
+		if limit > 0 && shown == limit {
+			break
+		}
+		entry := history.Entries[i]
+		shown++
+
+		level := levelInfo
+		suffix := ""
+		if entry.Undone {
+			level = levelWarn
+			suffix = " (undone)"
+		}
+		if err := printResult(cmd, level, entry, "%d %s %s: %s%s", entry.ID, entry.Time.Local().Format(time.RFC3339), entry.Command, describeOperations(entry.Operations), suffix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// describeOperations joins ops for a one-line summary.
+func describeOperations(ops []skills.Operation) string {
+	parts := make([]string, 0, len(ops))
+	for _, op := range ops {
+		parts = append(parts, op.String())
+	}
+	return strings.Join(parts, "; ")
+}
+
+// undoRecord is the structured form of one reverted, or unrevertable, operation.
+type undoRecord struct {
+	ID        int              `json:"id"`
+	Command   string           `json:"command"`
+	Operation skills.Operation `json:"operation"`
+	Status    string           `json:"status"`
+	Level     string           `json:"level"`
+	Error     string           `json:"error,omitempty"`
+	DryRun    bool             `json:"dry_run,omitempty"`
+}
+
+// newUndoCmd builds the command that reverses recorded operations.
+func newUndoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "undo [id]",
+		Short:       "Reverse the most recent recorded bond operation",
+		Long:        "Undo reverses the operations of one history entry, newest first: created links and copies are removed and removed links are restored. Without an id, the most recent entry that was not undone is reversed. Paths changed since bond touched them are left alone and reported.",
+		Args:        cobra.MaximumNArgs(1),
+		Annotations: dryRunAnnotations(dryRunSupported),
+		RunE:        runUndo,
+	}
+}
+
+// runUndo reverts one history entry and marks it undone when every operation was reverted.
+func runUndo(cmd *cobra.Command, args []string) error {
+	path, err := config.ProjectHistoryFile()
+	if err != nil {
+		return err
+	}
+	history, err := skills.LoadHistory(path)
+	if err != nil {
+		return err
+	}
+
+	entry, err := undoTarget(&history, args)
+	if err != nil {
+		return err
+	}
+
+	skillsDir, err := primarySkillsDir()
+	if err != nil {
+		return err
+	}
+	lockPath, err := config.ProjectLockFile()
+	if err != nil {
+		return err
+	}
+	lock, err := skills.LoadLockfile(lockPath)
+	if err != nil {
+		return err
+	}
+	lockChanged := false
+
+	failures := 0
+	for i := len(entry.Operations) - 1; i >= 0; i-- {
+		op := entry.Operations[i]
+		record := undoRecord{ID: entry.ID, Command: entry.Command, Operation: op, Status: "reverted", Level: levelOK, DryRun: dryRun}
+
+		if !dryRun {
+			if err := skills.RevertOperation(op); err != nil {
+				failures++
+				record.Status = "failed"
+				record.Level = levelWarn
+				record.Error = err.Error()
+				if err := printResult(cmd, levelWarn, record, "could not revert %s: %v", op, err); err != nil {
+					return err
+				}
+				continue
+			}
+			lockChanged = forgetRevertedCopy(&lock, skillsDir, op) || lockChanged
+		}
+
+		suffix := ""
+		if dryRun {
+			suffix = " (dry run)"
+		}
+		if err := printResult(cmd, levelOK, record, "reverted %s%s", op, suffix); err != nil {
+			return err
+		}
+	}
+
+	if dryRun {
+		return nil
+	}
+	if lockChanged {
+		if err := skills.SaveLockfile(lockPath, lock); err != nil {
+			return err
+		}
+	}
+	if failures > 0 {
+		// The entry stays active so undo can be retried once the paths are fixed.
+		return alreadyReportedFailure()
+	}
+	entry.Undone = true
+	return skills.SaveHistory(path, history)
+}
+
+// undoTarget picks the entry named by args, or the latest active one.
+func undoTarget(history *skills.History, args []string) (*skills.HistoryEntry, error) {
+	if len(args) == 0 {
+		entry, ok := history.LatestActive()
+		if !ok {
+			return nil, fmt.Errorf("nothing to undo")
+		}
+		return entry, nil
+	}
+
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid history id %q", args[0])
+	}
+	entry, ok := history.Find(id)
+	if !ok {
+		return nil, fmt.Errorf("no history entry %d", id)
+	}
+	if entry.Undone {
+		return nil, fmt.Errorf("history entry %d was already undone", id)
+	}
+	return entry, nil
+}
+
+// forgetRevertedCopy drops the bond.lock provenance of a copy that undo
+// removed from skillsDir, where recorded copies live.
+func forgetRevertedCopy(lock *skills.Lockfile, skillsDir string, op skills.Operation) bool {
+	if op.Kind != skills.OpCopyTree || filepath.Dir(op.Path) != skillsDir {
+		return false
+	}
+	name := filepath.Base(op.Path)
+	entry, ok := lock.Skills[name]
+	if !ok || entry.Source != op.Source {
+		return false
+	}
+	if _, err := os.Lstat(op.Path); !os.IsNotExist(err) {
+		return false
+	}
+	delete(lock.Skills, name)
+	return true
+}
@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+)
+
+func TestHistoryRecordsLinkAndUndoRemovesIt(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	dest := filepath.Join(projectRoot, ".agents", "skills", "go")
+
+	if _, err := executeRootForTest(t, "link", "go"); err != nil {
+		t.Fatalf("Execute(link) error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "history")
+	if err != nil {
+		t.Fatalf("Execute(history) error = %v", err)
+	}
+	if !strings.HasPrefix(output, "[INFO] 1 ") || !strings.HasSuffix(output, " bond link go: symlink "+dest+" -> "+storeSkill+"\n") {
+		t.Fatalf("history output = %q", output)
+	}
+
+	output, err = executeRootForTest(t, "undo")
+	if err != nil {
+		t.Fatalf("Execute(undo) error = %v", err)
+	}
+	if want := "[OK] reverted symlink " + dest + " -> " + storeSkill + "\n"; output != want {
+		t.Fatalf("undo output = %q, want %q", output, want)
+	}
+	if _, err := os.Lstat(dest); !os.IsNotExist(err) {
+		t.Fatalf("Lstat(dest) error = %v, want removed", err)
+	}
+
+	output, err = executeRootForTest(t, "history")
+	if err != nil {
+		t.Fatalf("Execute(history) error = %v", err)
+	}
+	if !strings.HasPrefix(output, "[WARN] 1 ") || !strings.HasSuffix(output, " (undone)\n") {
+		t.Fatalf("history output = %q, want undone entry", output)
+	}
+	if _, err := executeRootForTest(t, "undo"); err == nil || err.Error() != "nothing to undo" {
+		t.Fatalf("Execute(undo) error = %v, want nothing to undo", err)
+	}
+}
+
+func TestUndoRestoresUnlinkedSkill(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	dest := filepath.Join(projectRoot, ".agents", "skills", "go")
+	if err := os.Symlink(storeSkill, dest); err != nil {
+		t.Fatalf("Symlink() error = %v", err)
+	}
+
+	if _, err := executeRootForTest(t, "unlink", "go"); err != nil {
+		t.Fatalf("Execute(unlink) error = %v", err)
+	}
+	if _, err := executeRootForTest(t, "undo", "1"); err != nil {
+		t.Fatalf("Execute(undo 1) error = %v", err)
+	}
+	if target, err := os.Readlink(dest); err != nil || target != storeSkill {
+		t.Fatalf("Readlink() = %q, %v, want %q", target, err, storeSkill)
+	}
+}
+
+func TestUndoRemovesCopyAndItsLockEntry(t *testing.T) {
+	_, projectRoot := setupGoSkillProject(t)
+	dest := filepath.Join(projectRoot, ".agents", "skills", "go")
+
+	if _, err := executeRootForTest(t, "copy", "go"); err != nil {
+		t.Fatalf("Execute(copy) error = %v", err)
+	}
+	if _, err := executeRootForTest(t, "undo"); err != nil {
+		t.Fatalf("Execute(undo) error = %v", err)
+	}
+	if _, err := os.Lstat(dest); !os.IsNotExist(err) {
+		t.Fatalf("Lstat(dest) error = %v, want removed", err)
+	}
+	lock, err := skills.LoadLockfile(config.ProjectLockFrom(projectRoot))
+	if err != nil {
+		t.Fatalf("LoadLockfile() error = %v", err)
+	}
+	if _, ok := lock.Skills["go"]; ok {
+		t.Fatalf("lock.Skills = %#v, want go entry removed", lock.Skills)
+	}
+}
+
+func TestUndoLeavesEditedCopyAndKeepsEntryActive(t *testing.T) {
+	_, projectRoot := setupGoSkillProject(t)
+	dest := filepath.Join(projectRoot, ".agents", "skills", "go")
+
+	if _, err := executeRootForTest(t, "copy", "go"); err != nil {
+		t.Fatalf("Execute(copy) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dest, "SKILL.md"), []byte("edited"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "undo")
+	if !IsAlreadyReportedFailure(err) {
+		t.Fatalf("Execute(undo) error = %v, want already-reported failure", err)
+	}
+	if !strings.HasPrefix(output, "[WARN] could not revert copy tree ") {
+		t.Fatalf("undo output = %q", output)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "SKILL.md")); err != nil {
+		t.Fatalf("Stat(copy) error = %v, want kept", err)
+	}
+
+	history, err := skills.LoadHistory(config.ProjectHistoryFrom(projectRoot))
+	if err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	if _, ok := history.LatestActive(); !ok {
+		t.Fatal("LatestActive() ok = false, want entry still active")
+	}
+}
+
+func TestHistoryRecordsCommandFlags(t *testing.T) {
+	_, projectRoot := setupGoSkillProject(t)
+
+	if _, err := executeRootForTest(t, "link", "go"); err != nil {
+		t.Fatalf("Execute(link) error = %v", err)
+	}
+	if _, err := executeRootForTest(t, "unlink", "--all", "--atomic"); err != nil {
+		t.Fatalf("Execute(unlink --all --atomic) error = %v", err)
+	}
+
+	history, err := skills.LoadHistory(config.ProjectHistoryFrom(projectRoot))
+	if err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	if len(history.Entries) != 2 || history.Entries[1].Command != "bond unlink --all --atomic" {
+		t.Fatalf("history.Entries = %+v, want unlink recorded with its flags", history.Entries)
+	}
+}
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// indexRecord is the structured result of bond index.
+type indexRecord struct {
+	Path    string              `json:"path"`
+	Format  skills.IndexFormat  `json:"format"`
+	Status  string              `json:"status"`
+	Entries []skills.IndexEntry `json:"entries"`
+}
+
+// newIndexCmd builds the command that writes the project skill catalog.
+func newIndexCmd() *cobra.Command {
+	var formatFlag string
+	var check bool
+
+	cmd := &cobra.Command{
+		Use:         "index",
+		Short:       "Write a catalog of project skills for agents",
+		Long:        "Index reads the name and description of every project skill and writes them to a managed block in AGENTS.md (agents-md), to INDEX.md (markdown), or to index.json (json) in the project skills directory. With --check, nothing is written and the command fails when the index is stale.",
+		Args:        cobra.NoArgs,
+		Annotations: dryRunAnnotations(dryRunUnsupported),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := skills.ParseIndexFormat(formatFlag)
+			if err != nil {
+				return err
+			}
+			return runIndex(cmd, format, check)
+		},
+	}
+
+	cmd.Flags().StringVar(&formatFlag, "format", string(skills.IndexFormatAgentsMD), "Index format: agents-md, markdown, json")
+	cmd.Flags().BoolVar(&check, "check", false, "Fail if the index is out of date instead of writing it")
+	return cmd
+}
+
+// runIndex renders the index and writes or checks it.
+func runIndex(cmd *cobra.Command, format skills.IndexFormat, check bool) error {
+	path, err := indexFile(format)
+	if err != nil {
+		return err
+	}
+	skillsDir, err := primarySkillsDir()
+	if err != nil {
+		return err
+	}
+
+	entries, err := skills.BuildIndex(skillsDir, filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	current := ""
+	if raw, err := os.ReadFile(path); err == nil {
+		current = string(raw)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	updated, err := skills.RenderIndex(format, entries, current)
+	if err != nil {
+		return err
+	}
+
+	record := indexRecord{Path: path, Format: format, Entries: entries}
+	if updated == current {
+		record.Status = "up_to_date"
+		return printResult(cmd, levelInfo, record, "index up to date in %s", path)
+	}
+	if check {
+		record.Status = "stale"
+		if structuredOutput() {
+			if err := writeRecord(cmd.OutOrStdout(), record); err != nil {
+				return err
+			}
+		} else if err := printErr(cmd, levelError, "index is out of date in %s; run bond index --format %s", path, format); err != nil {
+			return err
+		}
+		return alreadyReportedFailure()
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, []byte(updated), 0o644); err != nil {
+		return err
+	}
+	record.Status = "written"
+	return printResult(cmd, levelOK, record, "wrote index to %s", path)
+}
+
+// indexFile returns where format is written: AGENTS.md at the project root,
+// or a standalone file in the primary skills directory.
+func indexFile(format skills.IndexFormat) (string, error) {
+	switch format {
+	case skills.IndexFormatAgentsMD:
+		root, err := config.ProjectRoot()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(root, "AGENTS.md"), nil
+	case skills.IndexFormatMarkdown, skills.IndexFormatJSON:
+		skillsDir, err := primarySkillsDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(skillsDir, skills.IndexFileName(format)), nil
+	default:
+		return "", fmt.Errorf("invalid index format %q", format)
+	}
+}
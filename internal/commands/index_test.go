@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIndexCommandWritesAndChecksAgentsMD(t *testing.T) {
+	tmp := t.TempDir()
+	projectSkills := filepath.Join(tmp, ".agents", "skills")
+	skillDir := filepath.Join(projectSkills, "go")
+	if err := os.MkdirAll(skillDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll(skillDir) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(skillDir, "SKILL.md"), []byte("---\nname: go\ndescription: Go conventions\n---\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "AGENTS.md"), []byte("# Project\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(AGENTS.md) error = %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+	chdirForTest(t, tmp)
+
+	run := func(args ...string) (string, error) {
+		buf := &bytes.Buffer{}
+		cmd := newIndexCmd()
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		cmd.SetOut(buf)
+		cmd.SetErr(buf)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	agentsPath := filepath.Join(tmp, "AGENTS.md")
+	if _, err := run("--check"); !IsAlreadyReportedFailure(err) {
+		t.Fatalf("check before index error = %v, want reported failure", err)
+	}
+
+	got, err := run()
+	if err != nil {
+		t.Fatalf("index error = %v", err)
+	}
+	if want := "[OK] wrote index to " + agentsPath + "\n"; got != want {
+		t.Fatalf("output = %q, want %q", got, want)
+	}
+	raw, err := os.ReadFile(agentsPath)
+	if err != nil {
+		t.Fatalf("ReadFile(AGENTS.md) error = %v", err)
+	}
+	want := "# Project\n\n<!-- bond:begin index -->\n## Available skills\n\n- [go](.agents/skills/go/SKILL.md): Go conventions\n<!-- bond:end index -->\n"
+	if string(raw) != want {
+		t.Fatalf("AGENTS.md = %q, want %q", raw, want)
+	}
+
+	if got, err := run("--check"); err != nil || !strings.HasPrefix(got, "[INFO] index up to date") {
+		t.Fatalf("check after index = %q, %v, want up to date", got, err)
+	}
+}
+
+func TestIndexCommandWritesJSON(t *testing.T) {
+	tmp := t.TempDir()
+	skillDir := filepath.Join(tmp, ".agents", "skills", "go")
+	if err := os.MkdirAll(skillDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll(skillDir) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(skillDir, "SKILL.md"), []byte("---\nname: go\ndescription: Go\n---\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+	chdirForTest(t, tmp)
+
+	cmd := newIndexCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(tmp, ".agents", "skills", "index.json"))
+	if err != nil {
+		t.Fatalf("ReadFile(index.json) error = %v", err)
+	}
+	want := "{\n  \"skills\": [\n    {\n      \"name\": \"go\",\n      \"description\": \"Go\",\n      \"path\": \"go/SKILL.md\"\n    }\n  ]\n}\n"
+	if string(raw) != want {
+		t.Fatalf("index.json = %q, want %q", raw, want)
+	}
+}
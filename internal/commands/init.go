@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"bond/internal/config"
+	"bond/internal/skills"
 	"github.com/spf13/cobra"
 )
 
@@ -13,8 +14,9 @@ func newInitCmd() *cobra.Command {
 	var store bool
 
 	cmd := &cobra.Command{
-		Use:   "init",
-		Short: "Initialize .agents/skills in the current project",
+		Use:         "init",
+		Short:       "Initialize .agents/skills (or every bond.yaml target) in the current project",
+		Annotations: dryRunAnnotations(dryRunSupported),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if store {
 				storeDir, err := config.StoreSkillsDir()
@@ -22,55 +24,52 @@ func newInitCmd() *cobra.Command {
 					return err
 				}
 
-				created, err := ensureDir(storeDir)
+				ops, err := initDir(storeDir)
 				if err != nil {
 					return err
 				}
 
-				level := levelInfo
-				message := "store bond directory already exists"
-				if created {
-					level = levelOK
-					message = "initialized store bond directory"
+				if len(ops) == 0 {
+					return printOut(cmd, levelInfo, "store bond directory already exists")
 				}
-
-				return printOut(cmd, level, message)
+				return printOut(cmd, levelOK, "initialized store bond directory%s", initSuffix(ops))
 			}
 
 			agentsDir, err := config.ProjectAgentsDir()
 			if err != nil {
 				return err
 			}
-			skillsDir, err := config.ProjectSkillsDir()
+			targets, err := projectTargets()
 			if err != nil {
 				return err
 			}
 
-			agentsCreated, err := ensureDir(agentsDir)
-			if err != nil {
-				return err
-			}
-			skillsCreated, err := ensureDir(skillsDir)
+			agentsOps, err := initDir(agentsDir)
 			if err != nil {
 				return err
 			}
 
-			createdCount := 0
-			if agentsCreated {
-				createdCount++
-			}
-			if skillsCreated {
-				createdCount++
-			}
+			for i, target := range targets {
+				ops, err := initDir(target.dir)
+				if err != nil {
+					return err
+				}
+				// .agents also holds bond state, so creating it counts toward the first target.
+				if i == 0 {
+					ops = append(agentsOps, ops...)
+				}
 
-			level := levelInfo
-			message := ".agents/skills already exists"
-			if createdCount > 0 {
-				level = levelOK
-				message = "initialized .agents/skills"
+				if len(ops) == 0 {
+					if err := printOut(cmd, levelInfo, "%s already exists", target.name); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := printOut(cmd, levelOK, "initialized %s%s", target.name, initSuffix(ops)); err != nil {
+					return err
+				}
 			}
-
-			return printOut(cmd, level, message)
+			return registerProject()
 		},
 	}
 
@@ -78,6 +77,27 @@ func newInitCmd() *cobra.Command {
 	return cmd
 }
 
+// initDir creates path when missing and returns the operations that did, or
+// in dry-run mode would, create it.
+func initDir(path string) ([]skills.Operation, error) {
+	ops, err := planDir(path)
+	if err != nil || len(ops) == 0 || dryRun {
+		return ops, err
+	}
+	if _, err := ensureDir(path); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+// initSuffix describes ops in dry-run mode and is empty otherwise.
+func initSuffix(ops []skills.Operation) string {
+	if !dryRun {
+		return ""
+	}
+	return dryRunSuffix(ops)
+}
+
 // ensureDir creates path when missing and reports whether it was created.
 func ensureDir(path string) (bool, error) {
 	if info, err := os.Stat(path); err == nil {
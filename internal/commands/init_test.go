@@ -8,25 +8,9 @@ import (
 	"testing"
 )
 
-func chdirForTest(t *testing.T, dir string) {
-	t.Helper()
-
-	prevWd, err := os.Getwd()
-	if err != nil {
-		t.Fatalf("os.Getwd() error = %v", err)
-	}
-	t.Cleanup(func() {
-		if chdirErr := os.Chdir(prevWd); chdirErr != nil {
-			t.Fatalf("restore cwd error = %v", chdirErr)
-		}
-	})
-	if err := os.Chdir(dir); err != nil {
-		t.Fatalf("os.Chdir() error = %v", err)
-	}
-}
-
 func TestInitCommandWhenDirectoriesAlreadyExist(t *testing.T) {
 	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
 	projectRoot := filepath.Join(tmp, "project")
 	projectSkills := filepath.Join(projectRoot, ".agents", "skills")
 	if err := os.MkdirAll(projectSkills, 0o755); err != nil {
@@ -54,6 +38,7 @@ func TestInitCommandWhenDirectoriesAlreadyExist(t *testing.T) {
 
 func TestInitCommandWhenDirectoriesAreMissing(t *testing.T) {
 	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
 	projectRoot := filepath.Join(tmp, "project")
 	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
 		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
@@ -85,8 +70,44 @@ func TestInitCommandWhenDirectoriesAreMissing(t *testing.T) {
 	}
 }
 
+func TestInitCommandCreatesEveryManifestTarget(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+	projectRoot := filepath.Join(tmp, "project")
+	if err := os.MkdirAll(filepath.Join(projectRoot, ".claude", "skills"), 0o755); err != nil {
+		t.Fatalf("MkdirAll(.claude/skills) error = %v", err)
+	}
+	manifest := "targets:\n  - .agents/skills\n  - .claude/skills\n  - .github/skills\n"
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte(manifest), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+
+	buf := &bytes.Buffer{}
+	cmd := newInitCmd()
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	want := "[OK] initialized .agents/skills\n[INFO] .claude/skills already exists\n[OK] initialized .github/skills\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("output = %q, want %q", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(projectRoot, ".github", "skills")); err != nil {
+		t.Fatalf("Stat(.github/skills) error = %v", err)
+	}
+}
+
 func TestInitCommandWhenDirectoriesAreMixed(t *testing.T) {
 	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
 	projectRoot := filepath.Join(tmp, "project")
 	if err := os.MkdirAll(filepath.Join(projectRoot, ".agents"), 0o755); err != nil {
 		t.Fatalf("MkdirAll(.agents) error = %v", err)
@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"bytes"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -35,14 +37,17 @@ func TestUnlinkCommandRequiresAtLeastOneSkillArg(t *testing.T) {
 	}
 }
 
-func TestSelectSkillsUsesOnlyExplicitArgs(t *testing.T) {
+func TestResolveSelectorsUsesOnlyExplicitArgs(t *testing.T) {
 	discovered := []skills.Skill{
 		{Name: "alpha", Path: "/tmp/alpha"},
 		{Name: "beta", Path: "/tmp/beta"},
 		{Name: "gamma", Path: "/tmp/gamma"},
 	}
 
-	selected := selectSkills(discovered, []string{"gamma", "alpha", "missing"})
+	selected, err := resolveSelectors(discovered, []string{"gamma", "alpha", "missing"}, selectorOptions{})
+	if err != nil {
+		t.Fatalf("resolveSelectors() error = %v", err)
+	}
 	if len(selected) != 2 {
 		t.Fatalf("len(selected) = %d, want 2", len(selected))
 	}
@@ -53,35 +58,126 @@ func TestSelectSkillsUsesOnlyExplicitArgs(t *testing.T) {
 		t.Fatalf("selected[1].Name = %q, want alpha", selected[1].Name)
 	}
 
-	none := selectSkills(discovered, nil)
-	if len(none) != 0 {
-		t.Fatalf("len(selectSkills(discovered, nil)) = %d, want 0", len(none))
+	if none, err := resolveSelectors(discovered, nil, selectorOptions{}); err == nil {
+		t.Fatalf("resolveSelectors(discovered, nil) = %+v, want nothing selected", none)
+	}
+}
+
+func TestResolveSelectorsResolvesStoreQualifiedNames(t *testing.T) {
+	discovered := []skills.Skill{
+		{Name: "go", Path: "/personal/go", Store: "personal"},
+		{Name: "go", Path: "/team/go", Store: "team"},
+	}
+
+	selected, err := resolveSelectors(discovered, []string{"go", "other:go"}, selectorOptions{})
+	if err != nil {
+		t.Fatalf("resolveSelectors() error = %v", err)
+	}
+	if len(selected) != 1 || selected[0].Path != "/personal/go" {
+		t.Fatalf("selected = %+v, want highest-priority store only", selected)
+	}
+
+	skill, err := selectOneSkill(discovered, "team:go", errors.New("not found"))
+	if err != nil {
+		t.Fatalf("selectOneSkill(team:go) error = %v", err)
+	}
+	if skill.Path != "/team/go" {
+		t.Fatalf("skill.Path = %q, want team store", skill.Path)
+	}
+}
+
+func TestLinkCommandLinksShadowedSkillByStoreName(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+	projectRoot := filepath.Join(tmp, "project")
+	personal := filepath.Join(tmp, "personal")
+	team := filepath.Join(tmp, "team")
+
+	for _, dir := range []string{projectRoot, filepath.Join(personal, "go"), filepath.Join(team, "go")} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("MkdirAll(%s) error = %v", dir, err)
+		}
+	}
+	for _, skillFile := range []string{filepath.Join(personal, "go", "SKILL.md"), filepath.Join(team, "go", "SKILL.md")} {
+		if err := os.WriteFile(skillFile, []byte("x"), 0o644); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", skillFile, err)
+		}
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("BOND_STORES", "personal="+personal+string(os.PathListSeparator)+"team="+team)
+
+	buf := &bytes.Buffer{}
+	cmd := newLinkCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"team:go"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if got, want := buf.String(), "[OK] linked go\n"; got != want {
+		t.Fatalf("output = %q, want %q", got, want)
+	}
+
+	target, err := os.Readlink(filepath.Join(projectRoot, ".agents", "skills", "go"))
+	if err != nil {
+		t.Fatalf("Readlink(go) error = %v", err)
+	}
+	if target != filepath.Join(team, "go") {
+		t.Fatalf("link target = %q, want team store", target)
 	}
 }
 
-func TestResolveUnlinkTargetsBuildsOnlyFromArgs(t *testing.T) {
-	skillsDir := filepath.Join("/tmp", "project", ".agents", "skills")
+func TestResolveUnlinkNamesKeepsExplicitNamesAndExpandsGlobs(t *testing.T) {
+	tmp := t.TempDir()
+	skillsDir := filepath.Join(tmp, ".agents", "skills")
+	if err := os.MkdirAll(skillsDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll(skillsDir) error = %v", err)
+	}
+	for _, name := range []string{"react-hooks", "react-router", "vue"} {
+		if err := os.Symlink(filepath.Join(tmp, "store", name), filepath.Join(skillsDir, name)); err != nil {
+			t.Fatalf("Symlink(%s) error = %v", name, err)
+		}
+	}
+	targets := []projectTarget{{name: ".agents/skills", dir: skillsDir}}
 
-	entries, err := resolveUnlinkTargets(skillsDir, []string{"one", "two"})
+	names, err := resolveUnlinkNames(targets, []string{"two", "one"}, selectorOptions{})
 	if err != nil {
-		t.Fatalf("resolveUnlinkTargets() error = %v", err)
+		t.Fatalf("resolveUnlinkNames() error = %v", err)
+	}
+	if strings.Join(names, ",") != "two,one" {
+		t.Fatalf("names = %v, want explicit names in arg order", names)
 	}
-	if len(entries) != 2 {
-		t.Fatalf("len(entries) = %d, want 2", len(entries))
+
+	names, err = resolveUnlinkNames(targets, []string{"react-*", "!react-router"}, selectorOptions{})
+	if err != nil {
+		t.Fatalf("resolveUnlinkNames(glob) error = %v", err)
 	}
-	if entries[0].Name != "one" || entries[0].Path != filepath.Join(skillsDir, "one") {
-		t.Fatalf("entries[0] = %+v, want name/path for one", entries[0])
+	if strings.Join(names, ",") != "react-hooks" {
+		t.Fatalf("names = %v, want [react-hooks]", names)
 	}
-	if entries[1].Name != "two" || entries[1].Path != filepath.Join(skillsDir, "two") {
-		t.Fatalf("entries[1] = %+v, want name/path for two", entries[1])
+
+	_, err = resolveUnlinkNames(targets, []string{"veu"}, selectorOptions{strict: true})
+	if err == nil || !strings.Contains(err.Error(), `"veu" matches no skill (did you mean vue?)`) {
+		t.Fatalf("resolveUnlinkNames(strict) error = %v, want did-you-mean", err)
 	}
+}
 
-	none, err := resolveUnlinkTargets(skillsDir, nil)
+func TestResolveUnlinkNamesBuildsOnlyFromArgs(t *testing.T) {
+	skillsDir := filepath.Join(t.TempDir(), ".agents", "skills")
+	targets := []projectTarget{{name: ".agents/skills", dir: skillsDir}}
+
+	names, err := resolveUnlinkNames(targets, []string{"one", "two"}, selectorOptions{})
 	if err != nil {
-		t.Fatalf("resolveUnlinkTargets(nil args) error = %v", err)
+		t.Fatalf("resolveUnlinkNames() error = %v", err)
+	}
+	if strings.Join(names, ",") != "one,two" {
+		t.Fatalf("names = %v, want [one two]", names)
 	}
-	if len(none) != 0 {
-		t.Fatalf("len(resolveUnlinkTargets(nil args)) = %d, want 0", len(none))
+
+	if none, err := resolveUnlinkNames(targets, nil, selectorOptions{}); err == nil {
+		t.Fatalf("resolveUnlinkNames(nil args) = %v, want nothing selected", none)
 	}
 }
 
@@ -154,3 +250,50 @@ func TestCompleteGlobalSkillsDuplicateNamesReturnNoCandidates(t *testing.T) {
 		t.Fatalf("len(candidates) = %d, want 0", len(candidates))
 	}
 }
+
+func TestLinkCommandSelectsAllAndFailsStrictly(t *testing.T) {
+	tmp := t.TempDir()
+	xdg := filepath.Join(tmp, "xdg")
+	for _, name := range []string{"go", "legacy-go", "react"} {
+		dir := filepath.Join(xdg, "bond", name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("MkdirAll(%s) error = %v", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("---\nname: "+name+"\n---\n"), 0o644); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", name, err)
+		}
+	}
+	projectRoot := filepath.Join(tmp, "project")
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	t.Setenv("BOND_STORES", "")
+	chdirForTest(t, projectRoot)
+
+	buf := &bytes.Buffer{}
+	cmd := newLinkCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"--all", "!legacy-*"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute(--all) error = %v", err)
+	}
+	if got, want := buf.String(), "[OK] linked go\n[OK] linked react\n"; got != want {
+		t.Fatalf("output = %q, want %q", got, want)
+	}
+
+	cmd = newLinkCmd()
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--strict", "go", "raect"})
+	err := cmd.Execute()
+	if err == nil || err.Error() != `"raect" matches no skill (did you mean react?)` {
+		t.Fatalf("Execute(--strict) error = %v, want did-you-mean error", err)
+	}
+	if _, err := os.Lstat(filepath.Join(projectRoot, ".agents", "skills", "legacy-go")); !os.IsNotExist(err) {
+		t.Fatalf("legacy-go should not be linked, err = %v", err)
+	}
+}
@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"bond/internal/config"
 	"bond/internal/skills"
 	"github.com/spf13/cobra"
 )
@@ -19,14 +18,14 @@ func newListCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().BoolVar(&storeOnly, "store", false, "List skills in the store directory")
+	cmd.Flags().BoolVar(&storeOnly, "store", false, "List skills in the configured stores")
 	return cmd
 }
 
 // runList prints project skills by default, or store skills with --store.
 func runList(cmd *cobra.Command, storeOnly bool) error {
 	if !storeOnly {
-		projectDir, err := config.ProjectSkillsDir()
+		projectDir, err := primarySkillsDir()
 		if err != nil {
 			return err
 		}
@@ -37,26 +36,53 @@ func runList(cmd *cobra.Command, storeOnly bool) error {
 		}
 
 		for _, skill := range discovered {
-			if err := printOut(cmd, levelInfo, "%s", skill.Name); err != nil {
+			if err := printResult(cmd, levelInfo, skill, "%s", skill.Name); err != nil {
 				return err
 			}
 		}
 		return nil
 	}
 
-	storeDir, err := config.StoreSkillsDir()
+	stores, err := configuredStores()
 	if err != nil {
 		return err
 	}
-	discovered, err := skills.Discover(storeDir)
+	visible, shadowed, err := skills.DiscoverStores(stores)
 	if err != nil {
 		return err
 	}
 
-	for _, skill := range discovered {
-		if err := printOut(cmd, levelInfo, "%s", skill.Name); err != nil {
+	// Store names only add information once more than one store is configured.
+	showStore := len(stores) > 1
+	for _, skill := range visible {
+		record := storeSkillRecord{Skill: skill}
+		if !showStore {
+			if err := printResult(cmd, levelInfo, record, "%s", skill.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := printResult(cmd, levelInfo, record, "%s (%s)", skill.Name, skill.Store); err != nil {
+			return err
+		}
+	}
+
+	shadowedBy := make(map[string]string, len(visible))
+	for _, skill := range visible {
+		shadowedBy[skill.Name] = skill.Store
+	}
+	for _, skill := range shadowed {
+		record := storeSkillRecord{Skill: skill, ShadowedBy: shadowedBy[skill.Name]}
+		if err := printResult(cmd, levelWarn, record, "%s (%s, shadowed by %s)", skill.Name, skill.Store, record.ShadowedBy); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// storeSkillRecord is the structured form of one store skill in list --store.
+type storeSkillRecord struct {
+	skills.Skill
+	// ShadowedBy names the higher-priority store providing the same skill name.
+	ShadowedBy string `json:"shadowed_by,omitempty"`
+}
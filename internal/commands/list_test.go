@@ -169,6 +169,51 @@ func TestListCommandStoreFlagListsStoreSkills(t *testing.T) {
 	}
 }
 
+func TestListCommandStoreFlagShowsStoresAndShadowing(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	personal := filepath.Join(tmp, "personal")
+	team := filepath.Join(tmp, "team")
+
+	for _, dir := range []string{
+		projectRoot,
+		filepath.Join(personal, "go"),
+		filepath.Join(team, "go"),
+		filepath.Join(team, "rust"),
+	} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("MkdirAll(%s) error = %v", dir, err)
+		}
+	}
+	for _, skillFile := range []string{
+		filepath.Join(personal, "go", "SKILL.md"),
+		filepath.Join(team, "go", "SKILL.md"),
+		filepath.Join(team, "rust", "SKILL.md"),
+	} {
+		if err := os.WriteFile(skillFile, []byte("x"), 0o644); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", skillFile, err)
+		}
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("BOND_STORES", "personal="+personal+string(os.PathListSeparator)+"team="+team)
+
+	buf := &bytes.Buffer{}
+	cmd := newListCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"--store"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	want := "[INFO] go (personal)\n[INFO] rust (team)\n[WARN] go (team, shadowed by personal)\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("output = %q, want %q", got, want)
+	}
+}
+
 func TestListCommandProjectFlagIsUnknown(t *testing.T) {
 	buf := &bytes.Buffer{}
 	cmd := newListCmd()
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -23,6 +24,12 @@ const (
 	colorModeNever  = "never"
 )
 
+const (
+	outputFormatText   = "text"
+	outputFormatJSON   = "json"
+	outputFormatNDJSON = "ndjson"
+)
+
 const (
 	envColorDisable = "BOND_NO_COLORS"
 	envNoLevel      = "BOND_NO_LEVEL"
@@ -39,8 +46,17 @@ const (
 var (
 	outputColorMode = colorModeAuto
 	outputShowLevel = true
+	outputFormat    = outputFormatText
+	// pendingRecords buffers json records until flushRecords writes them as one array.
+	pendingRecords []any
 )
 
+// messageRecord is the structured form of a plain output line.
+type messageRecord struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
 func parseColorMode(raw string) (string, error) {
 	mode := strings.ToLower(strings.TrimSpace(raw))
 	switch mode {
@@ -51,6 +67,26 @@ func parseColorMode(raw string) (string, error) {
 	}
 }
 
+func parseOutputFormat(raw string) (string, error) {
+	format := strings.ToLower(strings.TrimSpace(raw))
+	switch format {
+	case outputFormatText, outputFormatJSON, outputFormatNDJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid value for --output: %q (want text, json, or ndjson)", raw)
+	}
+}
+
+func setOutputFormat(format string) {
+	outputFormat = format
+	pendingRecords = nil
+}
+
+// structuredOutput reports whether results are emitted as records instead of text lines.
+func structuredOutput() bool {
+	return outputFormat == outputFormatJSON || outputFormat == outputFormatNDJSON
+}
+
 func setOutputColorMode(mode string) {
 	outputColorMode = mode
 }
@@ -136,15 +172,64 @@ func writeLevelLine(w io.Writer, level string, format string, args ...any) error
 	return err
 }
 
+// writeRecord emits one structured record: immediately for ndjson, buffered for json.
+func writeRecord(w io.Writer, record any) error {
+	if outputFormat == outputFormatJSON {
+		pendingRecords = append(pendingRecords, record)
+		return nil
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(record)
+}
+
+// flushRecords writes buffered json records as a single array.
+func flushRecords(w io.Writer) error {
+	if outputFormat != outputFormatJSON {
+		return nil
+	}
+	records := pendingRecords
+	pendingRecords = nil
+	if records == nil {
+		records = []any{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(records)
+}
+
 func printOut(cmd *cobra.Command, level string, format string, args ...any) error {
+	if structuredOutput() {
+		return writeRecord(cmd.OutOrStdout(), messageRecord{Level: level, Message: fmt.Sprintf(format, args...)})
+	}
 	return writeLevelLine(cmd.OutOrStdout(), level, format, args...)
 }
 
+// printErr writes to stderr in text mode. Structured records always go to stdout
+// so scripts read a single stream.
 func printErr(cmd *cobra.Command, level string, format string, args ...any) error {
+	if structuredOutput() {
+		return writeRecord(cmd.OutOrStdout(), messageRecord{Level: level, Message: fmt.Sprintf(format, args...)})
+	}
 	return writeLevelLine(cmd.ErrOrStderr(), level, format, args...)
 }
 
+// printResult writes a text line, or record when structured output is enabled.
+func printResult(cmd *cobra.Command, level string, record any, format string, args ...any) error {
+	if structuredOutput() {
+		return writeRecord(cmd.OutOrStdout(), record)
+	}
+	return writeLevelLine(cmd.OutOrStdout(), level, format, args...)
+}
+
 // PrintRootError writes a top-level CLI error using the shared output format.
 func PrintRootError(w io.Writer, err error) error {
+	if structuredOutput() {
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		return encoder.Encode(messageRecord{Level: levelError, Message: err.Error()})
+	}
 	return writeLevelLine(w, levelError, "%v", err)
 }
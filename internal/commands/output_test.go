@@ -2,6 +2,7 @@ package commands
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"os"
 	"testing"
@@ -28,6 +29,15 @@ func withOutputShowLevel(t *testing.T, show bool) {
 	})
 }
 
+func withOutputFormat(t *testing.T, format string) {
+	t.Helper()
+	prev := outputFormat
+	setOutputFormat(format)
+	t.Cleanup(func() {
+		setOutputFormat(prev)
+	})
+}
+
 func TestPrintOutPrefixesTagAndWritesStdout(t *testing.T) {
 	withOutputColorMode(t, colorModeNever)
 	withOutputShowLevel(t, true)
@@ -304,3 +314,74 @@ func TestPrintRootErrorWithoutLevelTag(t *testing.T) {
 		t.Fatalf("stderr = %q, want %q", got, want)
 	}
 }
+
+func TestParseOutputFormat(t *testing.T) {
+	for _, raw := range []string{"text", "JSON", " ndjson "} {
+		if _, err := parseOutputFormat(raw); err != nil {
+			t.Fatalf("parseOutputFormat(%q) error = %v", raw, err)
+		}
+	}
+
+	if _, err := parseOutputFormat("yaml"); err == nil {
+		t.Fatal("parseOutputFormat(yaml) error = nil, want non-nil")
+	}
+}
+
+func TestPrintErrNDJSONWritesRecordToStdout(t *testing.T) {
+	withOutputFormat(t, outputFormatNDJSON)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	if err := printErr(cmd, levelError, "%s: %s", "go", "boom"); err != nil {
+		t.Fatalf("printErr() error = %v", err)
+	}
+
+	if got, want := stdout.String(), "{\"level\":\"ERROR\",\"message\":\"go: boom\"}\n"; got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+	if got := stderr.String(); got != "" {
+		t.Fatalf("stderr = %q, want empty", got)
+	}
+}
+
+func TestFlushRecordsWritesJSONArray(t *testing.T) {
+	withOutputFormat(t, outputFormatJSON)
+
+	buf := &bytes.Buffer{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+
+	if err := printResult(cmd, levelInfo, skills.Skill{Name: "go", Path: "/store/go"}, "%s", "go"); err != nil {
+		t.Fatalf("printResult() error = %v", err)
+	}
+	if got := buf.String(); got != "" {
+		t.Fatalf("output before flush = %q, want empty", got)
+	}
+	if err := flushRecords(buf); err != nil {
+		t.Fatalf("flushRecords() error = %v", err)
+	}
+
+	var records []skills.Skill
+	if err := json.Unmarshal(buf.Bytes(), &records); err != nil {
+		t.Fatalf("Unmarshal() error = %v (output %q)", err, buf.String())
+	}
+	if len(records) != 1 || records[0].Name != "go" || records[0].Path != "/store/go" {
+		t.Fatalf("records = %+v, want one go record", records)
+	}
+}
+
+func TestFlushRecordsWritesEmptyArray(t *testing.T) {
+	withOutputFormat(t, outputFormatJSON)
+
+	buf := &bytes.Buffer{}
+	if err := flushRecords(buf); err != nil {
+		t.Fatalf("flushRecords() error = %v", err)
+	}
+	if got, want := buf.String(), "[]\n"; got != want {
+		t.Fatalf("output = %q, want %q", got, want)
+	}
+}
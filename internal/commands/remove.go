@@ -0,0 +1,237 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// newRemoveCmd builds the command that moves a store skill to the trash.
+func newRemoveCmd() *cobra.Command {
+	var unlink bool
+
+	cmd := &cobra.Command{
+		Use:         "remove <skill>",
+		Short:       "Move a store skill to the store trash",
+		Long:        "Remove moves a store skill into the store's .bond/trash directory, where bond restore can bring it back. Known projects that still link the skill are listed, since their links break; pass --unlink to remove those links too. Project copies are left alone.",
+		Args:        cobra.ExactArgs(1),
+		Annotations: dryRunAnnotations(dryRunSupported),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRemove(cmd, args[0], unlink)
+		},
+	}
+
+	cmd.Flags().BoolVar(&unlink, "unlink", false, "Also remove links to the skill from known projects")
+	cmd.ValidArgsFunction = completeStoreSkills
+	return cmd
+}
+
+// runRemove trashes the skill selected by name and deals with the known projects using it.
+func runRemove(cmd *cobra.Command, name string, unlink bool) error {
+	stores, err := configuredStores()
+	if err != nil {
+		return err
+	}
+	skill, err := resolveStoreSkill(stores, name)
+	if err != nil {
+		return err
+	}
+	store, err := storeFor(stores, skill)
+	if err != nil {
+		return err
+	}
+
+	// Usages are found first: telling links to this skill apart needs it in place.
+	registry, err := knownProjects(cmd)
+	if err != nil {
+		return err
+	}
+	usages, err := findProjectUsages(cmd, stores, registry, skill)
+	if err != nil {
+		return err
+	}
+
+	trashPath, err := uniqueBackupPath(config.StoreTrashDirFrom(store.Path), skill.Name, time.Now())
+	if err != nil {
+		return err
+	}
+	move := skills.Operation{Kind: skills.OpMoveTree, Path: trashPath, Source: skill.Path}
+	if !dryRun {
+		if err := trashSkill(store, skill, move); err != nil {
+			return err
+		}
+	}
+	output := skillActionOutput{level: levelOK, message: fmt.Sprintf("removed %s (restore with bond restore %s)", skill.Name, skill.Name), status: "removed", path: trashPath, ops: []skills.Operation{move}}
+	if dryRun {
+		output.message = fmt.Sprintf("removed %s", skill.Name)
+	}
+	if err := printSkillAction(cmd, skill.Name, output); err != nil {
+		return err
+	}
+
+	failures := 0
+	for _, usage := range usages {
+		target := filepath.Dir(usage.Path)
+		if usage.Mode == skills.UsageCopy {
+			output := skillActionOutput{level: levelInfo, message: fmt.Sprintf("kept copy of %s", skill.Name), status: string(usage.Status), path: usage.Path, target: target}
+			if err := printSkillAction(cmd, skill.Name, output); err != nil {
+				return err
+			}
+			continue
+		}
+		if !unlink {
+			output := skillActionOutput{level: levelWarn, message: fmt.Sprintf("broke link to %s (rerun with --unlink or run bond repair there)", skill.Name), status: "broken", path: usage.Path, target: target}
+			if err := printSkillAction(cmd, skill.Name, output); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// The project is not the one bond runs in, so its history is not touched.
+		remove := skills.Operation{Kind: skills.OpRemoveLink, Path: usage.Path, Source: skill.Path}
+		if !dryRun {
+			if err := skills.ApplyOperation(remove); err != nil {
+				failures++
+				if err := printSkillActionError(cmd, skill.Name, target, err); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		output := skillActionOutput{level: levelOK, message: fmt.Sprintf("unlinked %s", skill.Name), status: "unlinked", path: usage.Path, target: target, ops: []skills.Operation{remove}}
+		if err := printSkillAction(cmd, skill.Name, output); err != nil {
+			return err
+		}
+	}
+
+	if failures > 0 {
+		return alreadyReportedFailure()
+	}
+	return nil
+}
+
+// trashSkill applies move and records it in the store's trash index. When
+// the index cannot be saved, the skill is moved back, so restore never loses
+// track of a trashed skill.
+func trashSkill(store skills.StoreDir, skill skills.Skill, move skills.Operation) error {
+	path := config.StoreTrashFileFrom(store.Path)
+	trash, err := skills.LoadTrash(path)
+	if err != nil {
+		return err
+	}
+	if err := skills.ApplyOperation(move); err != nil {
+		return err
+	}
+	trash.Add(skill.Name, move.Path, skill.Path, time.Now())
+	if err := skills.SaveTrash(path, trash); err != nil {
+		back := skills.Operation{Kind: skills.OpMoveTree, Path: skill.Path, Source: move.Path}
+		if backErr := skills.ApplyOperation(back); backErr != nil {
+			return fmt.Errorf("%w; moving it back to %s: %v", err, skill.Path, backErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// trashedSkill is one trash entry together with the store holding it.
+type trashedSkill struct {
+	skills.TrashEntry
+	Store string `json:"store"`
+}
+
+// newRestoreCmd builds the command that brings a removed skill back.
+func newRestoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "restore [skill]",
+		Short:       "Restore a skill removed with bond remove",
+		Long:        "Restore moves the most recently removed skill with this name out of the store trash and back to where it was, which also mends links that broke when it was removed. Use store:skill to pick the store. Without a skill, it lists the trash.",
+		Args:        cobra.MaximumNArgs(1),
+		Annotations: dryRunAnnotations(dryRunSupported),
+		RunE:        runRestore,
+	}
+}
+
+// runRestore lists the trash, or restores the skill named in args.
+func runRestore(cmd *cobra.Command, args []string) error {
+	stores, err := configuredStores()
+	if err != nil {
+		return err
+	}
+	trashed, err := loadTrashed(stores)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		if len(trashed) == 0 && !structuredOutput() {
+			return printOut(cmd, levelInfo, "trash is empty")
+		}
+		for _, entry := range trashed {
+			if err := printResult(cmd, levelInfo, entry, "%s:%s %s (removed %s)", entry.Store, entry.Name, entry.Origin, entry.RemovedAt.Local().Format(time.RFC3339)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	entry, ok := latestTrashed(trashed, args[0])
+	if !ok {
+		return fmt.Errorf("%s is not in the trash", args[0])
+	}
+	move := skills.Operation{Kind: skills.OpMoveTree, Path: entry.Origin, Source: entry.Path}
+	if !dryRun {
+		if err := skills.ApplyOperation(move); err != nil {
+			return err
+		}
+		store, err := storeFor(stores, skills.Skill{Name: entry.Name, Store: entry.Store})
+		if err != nil {
+			return err
+		}
+		path := config.StoreTrashFileFrom(store.Path)
+		trash, err := skills.LoadTrash(path)
+		if err != nil {
+			return err
+		}
+		trash.Forget(entry.Path)
+		if err := skills.SaveTrash(path, trash); err != nil {
+			return err
+		}
+	}
+	return printSkillAction(cmd, entry.Name, skillActionOutput{level: levelOK, message: fmt.Sprintf("restored %s", entry.Name), status: "restored", path: entry.Origin, ops: []skills.Operation{move}})
+}
+
+// loadTrashed returns the trash entries of every store, most recently removed first.
+func loadTrashed(stores []skills.StoreDir) ([]trashedSkill, error) {
+	trashed := []trashedSkill{}
+	for _, store := range stores {
+		trash, err := skills.LoadTrash(config.StoreTrashFileFrom(store.Path))
+		if err != nil {
+			return nil, err
+		}
+		for i := len(trash.Entries) - 1; i >= 0; i-- {
+			trashed = append(trashed, trashedSkill{TrashEntry: trash.Entries[i], Store: store.Name})
+		}
+	}
+	sort.SliceStable(trashed, func(i, j int) bool { return trashed[i].RemovedAt.After(trashed[j].RemovedAt) })
+	return trashed, nil
+}
+
+// latestTrashed picks the most recently removed entry matching name or store:name.
+func latestTrashed(trashed []trashedSkill, name string) (trashedSkill, bool) {
+	storeName, skillName, qualified := strings.Cut(name, ":")
+	if !qualified {
+		skillName = name
+	}
+	for _, entry := range trashed {
+		if entry.Name == skillName && (!qualified || entry.Store == storeName) {
+			return entry, true
+		}
+	}
+	return trashedSkill{}, false
+}
@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bond/internal/config"
+)
+
+func TestRemoveWarnsAboutLinkingProjectsAndRestoreBringsSkillBack(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	link := filepath.Join(projectRoot, ".agents", "skills", "go")
+
+	if _, err := executeRootForTest(t, "link", "go"); err != nil {
+		t.Fatalf("Execute(link) error = %v", err)
+	}
+	output, err := executeRootForTest(t, "remove", "go")
+	if err != nil {
+		t.Fatalf("Execute(remove) error = %v", err)
+	}
+	if !strings.HasPrefix(output, "[OK] removed go (restore with bond restore go)\n") {
+		t.Fatalf("output = %q, want removed line first", output)
+	}
+	if want := "[WARN] broke link to go (rerun with --unlink or run bond repair there) in " + filepath.Dir(link) + "\n"; !strings.Contains(output, want) {
+		t.Fatalf("output = %q, missing %q", output, want)
+	}
+	if _, err := os.Stat(storeSkill); !os.IsNotExist(err) {
+		t.Fatalf("Stat(store skill) error = %v, want not exist", err)
+	}
+
+	output, err = executeRootForTest(t, "restore")
+	if err != nil {
+		t.Fatalf("Execute(restore) error = %v", err)
+	}
+	if !strings.Contains(output, ":go "+storeSkill+" (removed ") {
+		t.Fatalf("restore list = %q, want go entry", output)
+	}
+
+	output, err = executeRootForTest(t, "restore", "go")
+	if err != nil {
+		t.Fatalf("Execute(restore go) error = %v", err)
+	}
+	if output != "[OK] restored go\n" {
+		t.Fatalf("output = %q, want restored", output)
+	}
+	if _, err := os.Stat(filepath.Join(link, "SKILL.md")); err != nil {
+		t.Fatalf("Stat(link SKILL.md) error = %v, want link mended", err)
+	}
+
+	output, err = executeRootForTest(t, "restore")
+	if err != nil || output != "[INFO] trash is empty\n" {
+		t.Fatalf("Execute(restore) = %q, %v, want empty trash", output, err)
+	}
+}
+
+func TestRemoveUnlinkRemovesLinksFromKnownProjects(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	link := filepath.Join(projectRoot, ".agents", "skills", "go")
+
+	if _, err := executeRootForTest(t, "link", "go"); err != nil {
+		t.Fatalf("Execute(link) error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "--dry-run", "remove", "--unlink", "go")
+	if err != nil {
+		t.Fatalf("Execute(remove --dry-run) error = %v", err)
+	}
+	if want := "[OK] unlinked go in " + filepath.Dir(link) + " (dry run: remove link " + link + ")\n"; !strings.Contains(output, want) {
+		t.Fatalf("output = %q, missing %q", output, want)
+	}
+	if _, err := os.Stat(storeSkill); err != nil {
+		t.Fatalf("Stat(store skill) error = %v, want kept in dry run", err)
+	}
+
+	if _, err := executeRootForTest(t, "remove", "--unlink", "go"); err != nil {
+		t.Fatalf("Execute(remove --unlink) error = %v", err)
+	}
+	if _, err := os.Lstat(link); !os.IsNotExist(err) {
+		t.Fatalf("Lstat(link) error = %v, want removed", err)
+	}
+	entries, err := os.ReadDir(filepath.Join(filepath.Dir(storeSkill), ".bond", "trash"))
+	if err != nil || len(entries) != 1 || !strings.HasPrefix(entries[0].Name(), "go-") {
+		t.Fatalf("trash = %v, %v, want one go entry", entries, err)
+	}
+}
+
+func TestRemoveKeepsSkillWhenTrashIndexCannotBeWritten(t *testing.T) {
+	storeSkill, _ := setupGoSkillProject(t)
+	trashFile := config.StoreTrashFileFrom(filepath.Dir(storeSkill))
+	mustMkdirAll(t, filepath.Dir(trashFile))
+
+	// An unreadable index stops remove before anything moves.
+	mustMkdirAll(t, trashFile)
+	if _, err := executeRootForTest(t, "remove", "go"); err == nil {
+		t.Fatal("Execute(remove) error = nil, want trash index error")
+	}
+	if _, err := os.Stat(filepath.Join(storeSkill, "SKILL.md")); err != nil {
+		t.Fatalf("Stat(store skill) error = %v, want kept", err)
+	}
+
+	// An index that cannot be saved moves the skill back.
+	if err := os.Remove(trashFile); err != nil {
+		t.Fatalf("Remove(trash file) error = %v", err)
+	}
+	if err := os.Symlink(filepath.Join(t.TempDir(), "missing", "trash.yaml"), trashFile); err != nil {
+		t.Fatalf("Symlink(trash file) error = %v", err)
+	}
+	if _, err := executeRootForTest(t, "remove", "go"); err == nil {
+		t.Fatal("Execute(remove) error = nil, want trash index error")
+	}
+	if _, err := os.Stat(filepath.Join(storeSkill, "SKILL.md")); err != nil {
+		t.Fatalf("Stat(store skill) error = %v, want moved back", err)
+	}
+}
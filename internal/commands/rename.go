@@ -0,0 +1,198 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// newRenameCmd builds the command that renames a store skill and the project links to it.
+func newRenameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "rename <old> <new>",
+		Short:       "Rename a store skill and re-point links in known projects",
+		Long:        "Rename renames a store skill directory, rewrites the name field in its SKILL.md, and, in every known project, renames the skill in bond.yaml and replaces the links to it with links under the new name. When SKILL.md cannot be rewritten, nothing is renamed. Links, copies and manifests that cannot be updated are reported and left as they were.",
+		Args:        cobra.ExactArgs(2),
+		Annotations: dryRunAnnotations(dryRunSupported),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRename(cmd, args[0], args[1])
+		},
+	}
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return completeStoreSkills(cmd, args, toComplete)
+	}
+	return cmd
+}
+
+// runRename renames the store skill selected by oldName to newName and
+// re-points the known project links to it.
+func runRename(cmd *cobra.Command, oldName, newName string) error {
+	if err := validateNewSkillName(newName); err != nil {
+		return err
+	}
+	stores, err := configuredStores()
+	if err != nil {
+		return err
+	}
+	skill, err := resolveStoreSkill(stores, oldName)
+	if err != nil {
+		return err
+	}
+	if skill.Name == newName {
+		return fmt.Errorf("skill %s is already named %s", skill.Name, newName)
+	}
+	visible, err := visibleStoreSkills(stores)
+	if err != nil {
+		return err
+	}
+	if existing, ok := visible[newName]; ok {
+		return fmt.Errorf("skill %s already exists at %s", newName, existing.Path)
+	}
+
+	// Usages are found first: telling links to this skill apart needs it in place.
+	registry, err := knownProjects(cmd)
+	if err != nil {
+		return err
+	}
+	usages, err := findProjectUsages(cmd, stores, registry, skill)
+	if err != nil {
+		return err
+	}
+
+	newPath := filepath.Join(filepath.Dir(skill.Path), newName)
+	move := skills.Operation{Kind: skills.OpMoveTree, Path: newPath, Source: skill.Path}
+	rewrite := skills.Operation{Kind: skills.OpWriteFile, Path: filepath.Join(newPath, "SKILL.md")}
+	if !dryRun {
+		if err := renameSkillDir(move, newName); err != nil {
+			return err
+		}
+	}
+	output := skillActionOutput{level: levelOK, message: fmt.Sprintf("renamed %s to %s", skill.Name, newName), status: "renamed", path: newPath, ops: []skills.Operation{move, rewrite}}
+	if err := printSkillAction(cmd, skill.Name, output); err != nil {
+		return err
+	}
+
+	failures := 0
+	// Manifests name the visible skill, so renaming a shadowed one leaves them alone.
+	if visible[skill.Name].Path == skill.Path {
+		manifestFailures, err := renameManifestEntries(cmd, registry, skill.Name, newName)
+		if err != nil {
+			return err
+		}
+		failures += manifestFailures
+	}
+
+	for _, usage := range usages {
+		target := filepath.Dir(usage.Path)
+		if usage.Mode == skills.UsageCopy {
+			output := skillActionOutput{level: levelWarn, message: fmt.Sprintf("left copy of %s (copies keep their name and bond.lock source)", skill.Name), status: "not_updated", path: usage.Path, target: target}
+			if err := printSkillAction(cmd, skill.Name, output); err != nil {
+				return err
+			}
+			continue
+		}
+
+		newLink := filepath.Join(target, newName)
+		if _, err := os.Lstat(newLink); err == nil {
+			failures++
+			output := skillActionOutput{level: levelWarn, message: fmt.Sprintf("left link to %s (%s already exists)", skill.Name, newLink), status: "not_updated", path: usage.Path, target: target}
+			if err := printSkillAction(cmd, skill.Name, output); err != nil {
+				return err
+			}
+			continue
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+
+		// The project is not the one bond runs in, so its history is not touched.
+		ops := []skills.Operation{
+			{Kind: skills.OpRemoveLink, Path: usage.Path, Source: skill.Path},
+			{Kind: skills.OpSymlink, Path: newLink, Source: newPath},
+		}
+		if !dryRun {
+			if err := applyRelink(ops); err != nil {
+				failures++
+				if err := printSkillActionError(cmd, skill.Name, target, err); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		output := skillActionOutput{level: levelOK, message: fmt.Sprintf("relinked %s as %s", skill.Name, newName), status: "relinked", path: newLink, target: target, ops: ops}
+		if err := printSkillAction(cmd, skill.Name, output); err != nil {
+			return err
+		}
+	}
+
+	if failures > 0 {
+		return alreadyReportedFailure()
+	}
+	return nil
+}
+
+// renameSkillDir applies move and rewrites the frontmatter name. When the
+// name cannot be rewritten, the directory is moved back so the skill keeps a
+// name that matches its directory.
+func renameSkillDir(move skills.Operation, newName string) error {
+	if err := skills.ApplyOperation(move); err != nil {
+		return err
+	}
+	if err := skills.SetSkillName(move.Path, newName); err != nil {
+		back := skills.Operation{Kind: skills.OpMoveTree, Path: move.Source, Source: move.Path}
+		if backErr := skills.ApplyOperation(back); backErr != nil {
+			return fmt.Errorf("could not update the name in SKILL.md: %w; moving it back to %s: %v", err, move.Source, backErr)
+		}
+		return fmt.Errorf("could not update the name in SKILL.md, so the skill was not renamed: %w", err)
+	}
+	return nil
+}
+
+// renameManifestEntries renames the oldName entry in the bond.yaml of every
+// registered project and returns the number of manifests it could not update.
+func renameManifestEntries(cmd *cobra.Command, registry skills.ProjectRegistry, oldName, newName string) (int, error) {
+	failures := 0
+	for _, project := range registry.Projects {
+		path := config.ProjectManifestFrom(project.Path)
+		listed, err := skills.RenameManifestSkill(path, oldName, newName, !dryRun)
+		if err != nil {
+			failures++
+			output := skillActionOutput{level: levelWarn, message: fmt.Sprintf("left %s in manifest (%v)", oldName, err), status: "not_updated", path: path, target: project.Path}
+			if err := printSkillAction(cmd, oldName, output); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if !listed {
+			continue
+		}
+		ops := []skills.Operation{{Kind: skills.OpWriteFile, Path: path}}
+		output := skillActionOutput{level: levelOK, message: fmt.Sprintf("renamed %s to %s in manifest", oldName, newName), status: "renamed", path: path, target: project.Path, ops: ops}
+		if err := printSkillAction(cmd, oldName, output); err != nil {
+			return 0, err
+		}
+	}
+	return failures, nil
+}
+
+// applyRelink applies a link removal and its replacement, putting the old
+// link back when the new one cannot be created.
+func applyRelink(ops []skills.Operation) error {
+	if err := skills.ApplyOperation(ops[0]); err != nil {
+		return err
+	}
+	if err := skills.ApplyOperation(ops[1]); err != nil {
+		if revertErr := skills.RevertOperation(ops[0]); revertErr != nil {
+			return fmt.Errorf("%w; restoring %s: %v", err, ops[0].Path, revertErr)
+		}
+		return err
+	}
+	return nil
+}
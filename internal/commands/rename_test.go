@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenameMovesSkillRewritesNameAndRelinksProjects(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("---\nname: go\ndescription: Go tips\n---\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	skillsDir := filepath.Join(projectRoot, ".agents", "skills")
+	if _, err := executeRootForTest(t, "link", "go"); err != nil {
+		t.Fatalf("Execute(link) error = %v", err)
+	}
+
+	newSkill := filepath.Join(filepath.Dir(storeSkill), "golang")
+	output, err := executeRootForTest(t, "rename", "go", "golang")
+	if err != nil {
+		t.Fatalf("Execute(rename) error = %v; output = %q", err, output)
+	}
+	want := "[OK] renamed go to golang\n[OK] relinked go as golang in " + skillsDir + "\n"
+	if output != want {
+		t.Fatalf("output = %q, want %q", output, want)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(newSkill, "SKILL.md"))
+	if err != nil || !strings.HasPrefix(string(raw), "---\nname: golang\n") {
+		t.Fatalf("SKILL.md = %q, %v, want renamed frontmatter", raw, err)
+	}
+	if target, err := os.Readlink(filepath.Join(skillsDir, "golang")); err != nil || target != newSkill {
+		t.Fatalf("Readlink(golang) = %q, %v, want %q", target, err, newSkill)
+	}
+	if _, err := os.Lstat(filepath.Join(skillsDir, "go")); !os.IsNotExist(err) {
+		t.Fatalf("Lstat(go) error = %v, want old link removed", err)
+	}
+}
+
+func TestRenameRejectsInvalidNamesAndKeepsSkillWhenFrontmatterCannotBeUpdated(t *testing.T) {
+	storeSkill, _ := setupGoSkillProject(t)
+
+	if _, err := executeRootForTest(t, "rename", "go", "Go_Lang"); err == nil || !strings.Contains(err.Error(), "lowercase letters") {
+		t.Fatalf("Execute(rename Go_Lang) error = %v, want name rule error", err)
+	}
+
+	_, err := executeRootForTest(t, "rename", "go", "golang")
+	if err == nil || !strings.Contains(err.Error(), "so the skill was not renamed") {
+		t.Fatalf("Execute(rename) error = %v, want frontmatter error", err)
+	}
+	if _, err := os.Stat(filepath.Join(storeSkill, "SKILL.md")); err != nil {
+		t.Fatalf("Stat(go) error = %v, want directory moved back", err)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(storeSkill), "golang")); !os.IsNotExist(err) {
+		t.Fatalf("Stat(golang) error = %v, want not exist", err)
+	}
+}
+
+func TestRenameUpdatesManifestsOfKnownProjects(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("---\nname: go\ndescription: Go tips\n---\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	manifestPath := filepath.Join(projectRoot, "bond.yaml")
+	if err := os.WriteFile(manifestPath, []byte("skills:\n  - name: go # the Go skill\n    mode: link\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+	if _, err := executeRootForTest(t, "sync"); err != nil {
+		t.Fatalf("Execute(sync) error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "rename", "go", "golang")
+	if err != nil {
+		t.Fatalf("Execute(rename) error = %v; output = %q", err, output)
+	}
+	if want := "[OK] renamed go to golang in manifest in " + projectRoot + "\n"; !strings.Contains(output, want) {
+		t.Fatalf("output = %q, missing %q", output, want)
+	}
+	raw, err := os.ReadFile(manifestPath)
+	if err != nil || !strings.Contains(string(raw), "name: golang # the Go skill") {
+		t.Fatalf("bond.yaml = %q, %v, want renamed entry with comment", raw, err)
+	}
+
+	output, err = executeRootForTest(t, "sync")
+	if err != nil {
+		t.Fatalf("Execute(sync) error = %v; output = %q", err, output)
+	}
+	if output != "[INFO] already linked golang\n" {
+		t.Fatalf("sync output = %q, want golang already linked", output)
+	}
+}
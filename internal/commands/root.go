@@ -1,19 +1,65 @@
 package commands
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"os"
+
+	"bond/internal/config"
+	"github.com/spf13/cobra"
+)
 
 // Version is the CLI version string set at build time via ldflags.
 var Version = "dev"
 
 // Execute constructs and runs the root CLI command tree.
 func Execute() error {
-	return newRootCmd().Execute()
+	return executeRoot(newRootCmd())
+}
+
+// executeRoot runs cmd and flushes buffered structured output even when the command fails.
+func executeRoot(cmd *cobra.Command) error {
+	err := cmd.Execute()
+	if historyErr := flushHistory(); historyErr != nil && err == nil {
+		err = historyErr
+	}
+	if flushErr := flushRecords(cmd.OutOrStdout()); flushErr != nil && err == nil {
+		err = flushErr
+	}
+	return err
+}
+
+// useProjectRoot applies the --project override and reports the chosen root
+// when it is not the working directory.
+func useProjectRoot(cmd *cobra.Command, projectFlag string) error {
+	if projectFlag != "" {
+		info, err := os.Stat(projectFlag)
+		if err != nil || !info.IsDir() {
+			return fmt.Errorf("invalid value for --project: %q is not a directory", projectFlag)
+		}
+	}
+	config.SetProjectRootOverride(projectFlag)
+
+	root, err := config.ProjectRoot()
+	if err != nil {
+		return err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if root == wd || structuredOutput() {
+		return nil
+	}
+	return printErr(cmd, levelInfo, "using project root %s", root)
 }
 
 // newRootCmd creates the top-level bond command and wires subcommands.
 func newRootCmd() *cobra.Command {
 	var colorFlag string
 	var noLevelFlag bool
+	var outputFlag string
+	var projectFlag string
+	var dryRunFlag bool
 
 	cmd := &cobra.Command{
 		Use:           "bond",
@@ -23,12 +69,31 @@ func newRootCmd() *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			mode, err := parseColorMode(colorFlag)
+			// Flags win over environment variables, which win over config.yaml.
+			settings, err := config.LoadSettings()
+			if err != nil {
+				return err
+			}
+
+			colorRaw := colorFlag
+			if !cmd.Flags().Changed("color") {
+				if _, envSet := os.LookupEnv(envColorDisable); !envSet && settings.Color != "" {
+					colorRaw = settings.Color
+				}
+			}
+			mode, err := parseColorMode(colorRaw)
+			if err != nil {
+				return err
+			}
+			format, err := parseOutputFormat(outputFlag)
 			if err != nil {
 				return err
 			}
 
 			showLevel := true
+			if settings.NoLevel != nil {
+				showLevel = !*settings.NoLevel
+			}
 			noLevelFromEnv, envSet, err := parseNoLevelEnv()
 			if err != nil {
 				return err
@@ -42,22 +107,54 @@ func newRootCmd() *cobra.Command {
 
 			setOutputColorMode(mode)
 			setOutputShowLevel(showLevel)
-			return nil
+			setOutputFormat(format)
+			setDryRun(dryRunFlag)
+			startHistory(cmd, args)
+			if err := checkDryRunSupport(cmd); err != nil {
+				return err
+			}
+			if err := useProjectRoot(cmd, projectFlag); err != nil {
+				return err
+			}
+			// Only mutating commands carry a dry-run annotation; they recover
+			// a batch a previous run left half applied before doing anything else.
+			if dryRun || cmd.Annotations[annotationDryRun] == "" {
+				return nil
+			}
+			return recoverInterruptedBatch(cmd)
 		},
 	}
 	cmd.PersistentFlags().StringVar(&colorFlag, "color", colorModeAuto, "Colorize output: auto, always, never")
 	cmd.PersistentFlags().BoolVar(&noLevelFlag, "no-level", false, "Hide output level labels (INFO, OK, WARN, ERROR)")
+	cmd.PersistentFlags().StringVar(&outputFlag, "output", outputFormatText, "Output format: text, json, ndjson")
+	cmd.PersistentFlags().BoolVar(&dryRunFlag, "dry-run", false, "Report the filesystem operations mutating commands would perform without applying them")
+	cmd.PersistentFlags().StringVar(&projectFlag, "project", "", "Project root directory (default: nearest directory with .agents, bond.yaml, or .git)")
 
 	cmd.AddCommand(newInitCmd())
 	cmd.AddCommand(newListCmd())
 	cmd.AddCommand(newLinkCmd())
+	cmd.AddCommand(newConfigCmd())
 	cmd.AddCommand(newCopyCmd())
 	cmd.AddCommand(newCreateCmd())
+	cmd.AddCommand(newDiffCmd())
+	cmd.AddCommand(newDoctorCmd())
 	cmd.AddCommand(newEditCmd())
+	cmd.AddCommand(newHistoryCmd())
+	cmd.AddCommand(newIndexCmd())
+	cmd.AddCommand(newRemoveCmd())
+	cmd.AddCommand(newRenameCmd())
+	cmd.AddCommand(newRepairCmd())
+	cmd.AddCommand(newRestoreCmd())
 	cmd.AddCommand(newStoreCmd())
+	cmd.AddCommand(newSearchCmd())
+	cmd.AddCommand(newShowCmd())
 	cmd.AddCommand(newStatusCmd())
+	cmd.AddCommand(newSyncCmd())
+	cmd.AddCommand(newUndoCmd())
 	cmd.AddCommand(newUnlinkCmd())
+	cmd.AddCommand(newUpdateCmd())
 	cmd.AddCommand(newValidateCmd())
+	cmd.AddCommand(newWhereCmd())
 
 	return cmd
 }
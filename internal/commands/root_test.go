@@ -2,10 +2,14 @@ package commands
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"bond/internal/config"
+	"bond/internal/skills"
 )
 
 func withRootOutputShowLevel(t *testing.T, show bool) {
@@ -98,6 +102,7 @@ func TestRootNoLevelFlagAppliesToSubcommands(t *testing.T) {
 	withRootOutputShowLevel(t, true)
 
 	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
 	projectRoot := filepath.Join(tmp, "project")
 	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
 		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
@@ -124,6 +129,7 @@ func TestRootNoLevelFromEnvAppliesToSubcommands(t *testing.T) {
 	t.Setenv(envNoLevel, "true")
 
 	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
 	projectRoot := filepath.Join(tmp, "project")
 	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
 		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
@@ -150,6 +156,7 @@ func TestRootNoLevelFlagFalseOverridesEnvTrue(t *testing.T) {
 	t.Setenv(envNoLevel, "true")
 
 	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
 	projectRoot := filepath.Join(tmp, "project")
 	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
 		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
@@ -176,6 +183,7 @@ func TestRootNoLevelFlagTrueOverridesEnvFalse(t *testing.T) {
 	t.Setenv(envNoLevel, "false")
 
 	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
 	projectRoot := filepath.Join(tmp, "project")
 	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
 		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
@@ -211,3 +219,226 @@ func TestRootRejectsInvalidNoLevelEnvValue(t *testing.T) {
 		t.Fatalf("error = %q, want invalid BOND_NO_LEVEL message", err.Error())
 	}
 }
+
+func TestRootRejectsInvalidOutputFlagValue(t *testing.T) {
+	cmd := newRootCmd()
+	cmd.SetArgs([]string{"--output=yaml", "status"})
+
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatal("Execute() error = nil, want non-nil")
+	}
+	if !strings.Contains(err.Error(), `invalid value for --output: "yaml"`) {
+		t.Fatalf("error = %q, want invalid --output message", err.Error())
+	}
+}
+
+func TestRootOutputJSONLinkEmitsActionRecords(t *testing.T) {
+	withOutputFormat(t, outputFormatText)
+
+	tmp := t.TempDir()
+	xdg := filepath.Join(tmp, "xdg")
+	storeSkill := filepath.Join(xdg, "bond", "go")
+	if err := os.MkdirAll(storeSkill, 0o755); err != nil {
+		t.Fatalf("MkdirAll(storeSkill) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("---\nname: go\ndescription: Go skill\n---\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+
+	projectRoot := filepath.Join(tmp, "project")
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	chdirForTest(t, projectRoot)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := newRootCmd()
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{"--output", "json", "link", "go", "missing"})
+
+	if err := executeRoot(cmd); err != nil {
+		t.Fatalf("executeRoot() error = %v", err)
+	}
+
+	var records []skillActionRecord
+	if err := json.Unmarshal(stdout.Bytes(), &records); err != nil {
+		t.Fatalf("Unmarshal() error = %v (output %q)", err, stdout.String())
+	}
+	if len(records) != 1 {
+		t.Fatalf("records = %+v, want 1", records)
+	}
+	got := records[0]
+	if got.Name != "go" || got.Action != "link" || got.Status != "linked" || got.Level != levelOK {
+		t.Fatalf("record = %+v, want linked go", got)
+	}
+	if want := filepath.Join(projectRoot, ".agents", "skills", "go"); got.Path != want {
+		t.Fatalf("record path = %q, want %q", got.Path, want)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("stderr = %q, want empty", stderr.String())
+	}
+}
+
+func TestRootOutputNDJSONStatusEmitsReport(t *testing.T) {
+	withOutputFormat(t, outputFormatText)
+
+	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+	projectRoot := filepath.Join(tmp, "project")
+	if err := os.MkdirAll(filepath.Join(projectRoot, ".agents", "skills", "local"), 0o755); err != nil {
+		t.Fatalf("MkdirAll(local) error = %v", err)
+	}
+	chdirForTest(t, projectRoot)
+
+	buf := &bytes.Buffer{}
+	cmd := newRootCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"--output=ndjson", "status"})
+
+	if err := executeRoot(cmd); err != nil {
+		t.Fatalf("executeRoot() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("output lines = %q, want one record", lines)
+	}
+	var report skills.StatusReport
+	if err := json.Unmarshal([]byte(lines[0]), &report); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(report.Entries) != 1 || report.Entries[0].Name != "local" || report.Entries[0].Status != skills.StatusProjectOnly {
+		t.Fatalf("entries = %+v, want one project_only local entry", report.Entries)
+	}
+}
+
+func TestRootNoLevelFromConfigYieldsToEnvAndFlag(t *testing.T) {
+	withRootOutputShowLevel(t, true)
+
+	tmp := t.TempDir()
+	xdg := filepath.Join(tmp, "xdg")
+	if err := os.MkdirAll(filepath.Join(xdg, "bond"), 0o755); err != nil {
+		t.Fatalf("MkdirAll(config dir) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(xdg, "bond", "config.yaml"), []byte("no_level: true\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(config.yaml) error = %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+
+	projectRoot := filepath.Join(tmp, "project")
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	chdirForTest(t, projectRoot)
+
+	run := func(args ...string) string {
+		t.Helper()
+		buf := &bytes.Buffer{}
+		cmd := newRootCmd()
+		cmd.SetOut(buf)
+		cmd.SetErr(buf)
+		cmd.SetArgs(append(args, "init"))
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("Execute(%v) error = %v", args, err)
+		}
+		return buf.String()
+	}
+
+	if got, want := run(), "initialized .agents/skills\n"; got != want {
+		t.Fatalf("config output = %q, want %q", got, want)
+	}
+
+	t.Setenv(envNoLevel, "false")
+	if got, want := run(), "[INFO] .agents/skills already exists\n"; got != want {
+		t.Fatalf("env output = %q, want %q", got, want)
+	}
+
+	t.Setenv(envNoLevel, "")
+	os.Unsetenv(envNoLevel)
+	if got, want := run("--no-level=false"), "[INFO] .agents/skills already exists\n"; got != want {
+		t.Fatalf("flag output = %q, want %q", got, want)
+	}
+}
+
+func TestRootUsesNearestProjectRootFromSubdirectory(t *testing.T) {
+	withRootOutputShowLevel(t, true)
+	t.Cleanup(func() { config.SetProjectRootOverride("") })
+
+	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+	projectRoot := filepath.Join(tmp, "project")
+	nested := filepath.Join(projectRoot, "src", "pkg")
+	if err := os.MkdirAll(filepath.Join(projectRoot, ".git"), 0o755); err != nil {
+		t.Fatalf("MkdirAll(.git) error = %v", err)
+	}
+	if err := os.MkdirAll(nested, 0o755); err != nil {
+		t.Fatalf("MkdirAll(nested) error = %v", err)
+	}
+	chdirForTest(t, nested)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := newRootCmd()
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{"init"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if got, want := stderr.String(), "[INFO] using project root "+projectRoot+"\n"; got != want {
+		t.Fatalf("stderr = %q, want %q", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(projectRoot, ".agents", "skills")); err != nil {
+		t.Fatalf("Stat(root skills) error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(nested, ".agents")); !os.IsNotExist(err) {
+		t.Fatalf("Stat(nested .agents) error = %v, want not exist", err)
+	}
+}
+
+func TestRootProjectFlagOverridesDetection(t *testing.T) {
+	withRootOutputShowLevel(t, true)
+	t.Cleanup(func() { config.SetProjectRootOverride("") })
+
+	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+	other := filepath.Join(tmp, "other")
+	cwd := filepath.Join(tmp, "cwd")
+	for _, dir := range []string{other, cwd} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("MkdirAll(%s) error = %v", dir, err)
+		}
+	}
+	chdirForTest(t, cwd)
+
+	buf := &bytes.Buffer{}
+	cmd := newRootCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"--project", other, "init"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(other, ".agents", "skills")); err != nil {
+		t.Fatalf("Stat(other skills) error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "[INFO] using project root "+other+"\n") {
+		t.Fatalf("output = %q, want chosen root message", buf.String())
+	}
+
+	cmd = newRootCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--project", filepath.Join(tmp, "missing"), "status"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "invalid value for --project") {
+		t.Fatalf("Execute() error = %v, want invalid --project", err)
+	}
+}
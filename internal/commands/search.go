@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"strings"
+
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// newSearchCmd builds the command that ranks store skills against a query.
+func newSearchCmd() *cobra.Command {
+	var includeBody bool
+
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search store skills by name, description, and tags",
+		Long:  "Search ranks skills from the configured stores by fuzzy match on their name, and by matches in their tags and description. Every word of the query must match. Pass --body to also search SKILL.md contents.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearch(cmd, args, includeBody)
+		},
+	}
+
+	cmd.Flags().BoolVar(&includeBody, "body", false, "Also match SKILL.md bodies")
+	return cmd
+}
+
+// runSearch prints matching store skills, best match first.
+func runSearch(cmd *cobra.Command, args []string, includeBody bool) error {
+	stores, err := configuredStores()
+	if err != nil {
+		return err
+	}
+	visible, _, err := skills.DiscoverStores(stores)
+	if err != nil {
+		return err
+	}
+
+	query := strings.Join(args, " ")
+	results, err := skills.Search(visible, query, includeBody)
+	if err != nil {
+		return err
+	}
+
+	if len(results) == 0 && !structuredOutput() {
+		return printOut(cmd, levelInfo, "no skills match %q", query)
+	}
+
+	// Store names only add information once more than one store is configured.
+	showStore := len(stores) > 1
+	for _, result := range results {
+		label := result.Name
+		if showStore {
+			label += " (" + result.Store + ")"
+		}
+		if result.Meta.Description == "" {
+			if err := printResult(cmd, levelInfo, result, "%s", label); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := printResult(cmd, levelInfo, result, "%s - %s", label, result.Meta.Description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSearchCommandPrintsRankedStoreSkills(t *testing.T) {
+	tmp := t.TempDir()
+	xdg := filepath.Join(tmp, "xdg")
+	skillsByName := map[string]string{
+		"go":    "---\nname: go\ndescription: Go conventions\ntags: [backend]\n---\n",
+		"react": "---\nname: react\ndescription: React components\ntags: [frontend]\n---\n",
+		"node":  "---\nname: node\ndescription: Node services\ntags: [backend]\n---\n",
+	}
+	for name, contents := range skillsByName {
+		dir := filepath.Join(xdg, "bond", name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("MkdirAll(%s) error = %v", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte(contents), 0o644); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", name, err)
+		}
+	}
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	t.Setenv("BOND_STORES", "")
+	chdirForTest(t, tmp)
+
+	run := func(args ...string) string {
+		t.Helper()
+		buf := &bytes.Buffer{}
+		cmd := newSearchCmd()
+		cmd.SetOut(buf)
+		cmd.SetErr(buf)
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("Execute(%v) error = %v", args, err)
+		}
+		return buf.String()
+	}
+
+	if got, want := run("backend"), "[INFO] go - Go conventions\n[INFO] node - Node services\n"; got != want {
+		t.Fatalf("search backend = %q, want %q", got, want)
+	}
+	if got, want := run("python"), "[INFO] no skills match \"python\"\n"; got != want {
+		t.Fatalf("search python = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// selectorHelp documents the selector syntax in command help.
+const selectorHelp = "Selectors are skill names, store:skill names, globs such as react-*, or tag:name; prefix one with ! to exclude what it matches. --all selects every skill, and --strict fails on selectors that match nothing."
+
+// selectorOptions holds the selection flags shared by action commands.
+type selectorOptions struct {
+	all    bool
+	strict bool
+}
+
+// addSelectorFlags registers --all and --strict on cmd. Selectors are then
+// required unless --all is set.
+func addSelectorFlags(cmd *cobra.Command, opts *selectorOptions) {
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Select every available skill; combine with !pattern to exclude some")
+	cmd.Flags().BoolVar(&opts.strict, "strict", false, "Fail when a selector matches nothing")
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		if opts.all {
+			return nil
+		}
+		return cobra.MinimumNArgs(1)(cmd, args)
+	}
+}
+
+// resolveSelectors applies selectors to discovered skills. In strict mode, a
+// selector that matches nothing is an error with did-you-mean suggestions;
+// otherwise it is ignored so completion and manual input behave the same.
+func resolveSelectors(discovered []skills.Skill, args []string, opts selectorOptions) ([]skills.Skill, error) {
+	selection, err := skills.SelectSkills(discovered, args, opts.all)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStrictSelection(discovered, selection, opts); err != nil {
+		return nil, err
+	}
+
+	if len(selection.Skills) == 0 {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("no skills available")
+		}
+		return nil, fmt.Errorf("no matching skills: %s", strings.Join(args, ", "))
+	}
+	return selection.Skills, nil
+}
+
+// checkStrictSelection fails in strict mode when any selector matched nothing,
+// suggesting nearby names from discovered.
+func checkStrictSelection(discovered []skills.Skill, selection skills.Selection, opts selectorOptions) error {
+	if !opts.strict || len(selection.Unmatched) == 0 {
+		return nil
+	}
+
+	problems := make([]string, 0, len(selection.Unmatched))
+	for _, selector := range selection.Unmatched {
+		problem := fmt.Sprintf("%q matches no skill", selector)
+		if suggestions := skills.SuggestSkillNames(discovered, selector); len(suggestions) > 0 {
+			problem += fmt.Sprintf(" (did you mean %s?)", strings.Join(suggestions, ", "))
+		}
+		problems = append(problems, problem)
+	}
+	return fmt.Errorf("%s", strings.Join(problems, "; "))
+}
+
+// selectOneSkill resolves name for commands that act on a single skill. It
+// takes the same selectors as batch commands but must match exactly one
+// skill; when nothing matches, notFound is returned with did-you-mean
+// suggestions from discovered.
+func selectOneSkill(discovered []skills.Skill, name string, notFound error) (skills.Skill, error) {
+	selection, err := skills.SelectSkills(discovered, []string{name}, false)
+	if err != nil {
+		return skills.Skill{}, err
+	}
+	switch len(selection.Skills) {
+	case 0:
+		if suggestions := skills.SuggestSkillNames(discovered, name); len(suggestions) > 0 {
+			return skills.Skill{}, fmt.Errorf("%w (did you mean %s?)", notFound, strings.Join(suggestions, ", "))
+		}
+		return skills.Skill{}, notFound
+	case 1:
+		return selection.Skills[0], nil
+	default:
+		names := make([]string, 0, len(selection.Skills))
+		for _, skill := range selection.Skills {
+			names = append(names, skill.Name)
+		}
+		return skills.Skill{}, fmt.Errorf("%q matches several skills (%s); name one", name, strings.Join(names, ", "))
+	}
+}
@@ -0,0 +1,264 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// Project states reported by bond show.
+const (
+	showStateLinked   = "linked"
+	showStateExternal = "external"
+	showStateCopied   = "copied"
+	showStateModified = "modified"
+	showStateLocal    = "local"
+)
+
+// showRecord is the structured form of bond show.
+type showRecord struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// Target is where a symlinked project skill resolves to.
+	Target string `json:"target,omitempty"`
+	Store  string `json:"store,omitempty"`
+	// State is set for project skills only.
+	State    string                   `json:"state,omitempty"`
+	Source   string                   `json:"source,omitempty"`
+	Metadata skills.Metadata          `json:"metadata"`
+	Files    []skills.SkillFile       `json:"files"`
+	Tokens   int                      `json:"tokens"`
+	Issues   []skills.ValidationIssue `json:"issues"`
+}
+
+// newShowCmd builds the command that prints details about one skill.
+func newShowCmd() *cobra.Command {
+	var storeOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "show <skill>",
+		Short: "Show details about a project or store skill",
+		Long:  "Show prints a skill's path, store, frontmatter, files, estimated SKILL.md token count, and validation issues. Project skills are shown first, with whether they are linked, copied, or locally modified; pass --store to show the store skill instead.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runShow(cmd, args[0], storeOnly)
+		},
+	}
+
+	cmd.Flags().BoolVar(&storeOnly, "store", false, "Show the store skill even when the project has one")
+	cmd.ValidArgsFunction = completeStoreSkills
+	return cmd
+}
+
+// runShow resolves name in the project, then the stores, and prints its details.
+func runShow(cmd *cobra.Command, name string, storeOnly bool) error {
+	stores, err := configuredStores()
+	if err != nil {
+		return err
+	}
+
+	record := showRecord{}
+	found := false
+	if !storeOnly && !strings.Contains(name, ":") {
+		record, found, err = inspectProjectSkill(name, stores)
+		if err != nil {
+			return err
+		}
+	}
+	if !found {
+		discovered, err := discoverStoreSkills(stores)
+		if err != nil {
+			return err
+		}
+		selected, err := selectOneSkill(discovered, name, fmt.Errorf("%s: %w", name, errSkillNotInStores(stores)))
+		if err != nil {
+			return err
+		}
+		record = showRecord{Name: selected.Name, Path: selected.Path, Store: selected.Store}
+	}
+
+	dir := record.Path
+	if record.Target != "" {
+		dir = record.Target
+	}
+	record.Metadata = skills.ReadMetadata(dir)
+	if record.Files, err = skills.ListSkillFiles(dir); err != nil {
+		return err
+	}
+	if record.Tokens, err = skills.EstimateSkillTokens(dir); err != nil {
+		return err
+	}
+	validation, err := skills.ValidateSkillDir(record.Path)
+	if err != nil {
+		return err
+	}
+	record.Issues = validation.Issues
+	if record.Issues == nil {
+		record.Issues = []skills.ValidationIssue{}
+	}
+
+	if structuredOutput() {
+		return writeRecord(cmd.OutOrStdout(), record)
+	}
+	return printShowRecord(cmd, record)
+}
+
+// inspectProjectSkill looks name up in the primary project skills directory
+// and classifies how it got there.
+func inspectProjectSkill(name string, stores []skills.StoreDir) (showRecord, bool, error) {
+	projectDir, err := primarySkillsDir()
+	if err != nil {
+		return showRecord{}, false, err
+	}
+	report, err := skills.InspectStatusStores(stores, projectDir)
+	if err != nil {
+		return showRecord{}, false, err
+	}
+
+	for _, entry := range report.Entries {
+		if entry.Name != name || entry.Status == skills.StatusBroken {
+			continue
+		}
+		record := showRecord{Name: entry.Name, Path: entry.Path, Store: entry.Store}
+
+		switch entry.Status {
+		case skills.StatusLinked, skills.StatusExternal:
+			target, err := filepath.EvalSymlinks(entry.Path)
+			if err != nil {
+				return showRecord{}, false, err
+			}
+			record.Target = target
+			record.State = showStateExternal
+			if entry.Status == skills.StatusLinked {
+				record.State = showStateLinked
+			}
+			return record, true, nil
+		default:
+			if _, err := os.Stat(filepath.Join(entry.Path, "SKILL.md")); err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+				return showRecord{}, false, err
+			}
+			if err := classifyProjectCopy(&record); err != nil {
+				return showRecord{}, false, err
+			}
+			return record, true, nil
+		}
+	}
+	return showRecord{}, false, nil
+}
+
+// classifyProjectCopy sets the state of a project directory from bond.lock.
+func classifyProjectCopy(record *showRecord) error {
+	lockPath, err := config.ProjectLockFile()
+	if err != nil {
+		return err
+	}
+	lock, err := skills.LoadLockfile(lockPath)
+	if err != nil {
+		return err
+	}
+
+	entry, ok := lock.Skills[record.Name]
+	if !ok {
+		record.State = showStateLocal
+		return nil
+	}
+	record.Source = entry.Source
+	drift, err := entry.Drift(record.Path)
+	if err != nil {
+		return err
+	}
+	record.State = showStateCopied
+	if drift.ProjectChanged {
+		record.State = showStateModified
+	}
+	return nil
+}
+
+// printShowRecord writes record as labelled lines.
+func printShowRecord(cmd *cobra.Command, record showRecord) error {
+	lines := [][2]string{{"name", record.Name}, {"path", record.Path}}
+	if record.Target != "" {
+		lines = append(lines, [2]string{"target", record.Target})
+	}
+	lines = append(lines,
+		[2]string{"store", record.Store},
+		[2]string{"state", record.State},
+		[2]string{"source", record.Source},
+		[2]string{"description", record.Metadata.Description},
+		[2]string{"version", record.Metadata.Version},
+		[2]string{"tags", strings.Join(record.Metadata.Tags, ", ")},
+		[2]string{"license", record.Metadata.License},
+		[2]string{"allowed-tools", strings.Join(record.Metadata.AllowedTools, " ")},
+		[2]string{"requires", strings.Join(record.Metadata.Requires, ", ")},
+	)
+
+	keys := make([]string, 0, len(record.Metadata.Metadata))
+	for key := range record.Metadata.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		lines = append(lines, [2]string{"metadata", key + "=" + record.Metadata.Metadata[key]})
+	}
+	lines = append(lines, [2]string{"tokens", fmt.Sprintf("~%d (SKILL.md)", record.Tokens)})
+
+	for _, line := range lines {
+		if line[1] == "" {
+			continue
+		}
+		if err := printOut(cmd, levelInfo, "%s: %s", line[0], line[1]); err != nil {
+			return err
+		}
+	}
+
+	if err := printOut(cmd, levelInfo, "files:"); err != nil {
+		return err
+	}
+	for _, file := range record.Files {
+		indent := strings.Repeat("  ", strings.Count(file.Path, "/")+1)
+		entry := path.Base(file.Path) + "/"
+		if !file.IsDir {
+			entry = fmt.Sprintf("%s (%s)", path.Base(file.Path), formatSize(file.Size))
+		}
+		if err := printOut(cmd, levelInfo, "%s%s", indent, entry); err != nil {
+			return err
+		}
+	}
+
+	if len(record.Issues) == 0 {
+		return printOut(cmd, levelOK, "valid")
+	}
+	for _, issue := range record.Issues {
+		if err := printOut(cmd, levelWarn, "%s: %s", issue.Rule, issue.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatSize renders a byte count with a binary unit.
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	value, suffix := float64(size)/unit, "KiB"
+	for _, next := range []string{"MiB", "GiB"} {
+		if value < unit {
+			break
+		}
+		value, suffix = value/unit, next
+	}
+	return fmt.Sprintf("%.1f %s", value, suffix)
+}
@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestShowCommandPrintsLinkedProjectSkill(t *testing.T) {
+	tmp := t.TempDir()
+	xdg := filepath.Join(tmp, "xdg")
+	storeSkill := filepath.Join(xdg, "bond", "go")
+	projectSkills := filepath.Join(tmp, "project", ".agents", "skills")
+	for _, dir := range []string{filepath.Join(storeSkill, "scripts"), projectSkills} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("MkdirAll(%s) error = %v", dir, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("---\nname: go\ndescription: Go conventions\ntags: [lang]\n---\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "scripts", "fmt.sh"), []byte("gofmt\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(fmt.sh) error = %v", err)
+	}
+	if err := os.Symlink(storeSkill, filepath.Join(projectSkills, "go")); err != nil {
+		t.Fatalf("Symlink() error = %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	chdirForTest(t, filepath.Join(tmp, "project"))
+
+	buf := &bytes.Buffer{}
+	cmd := newShowCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"go"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{
+		"[INFO] path: " + filepath.Join(projectSkills, "go") + "\n",
+		"[INFO] store: default\n",
+		"[INFO] state: linked\n",
+		"[INFO] description: Go conventions\n",
+		"[INFO] tags: lang\n",
+		"[INFO]   SKILL.md (",
+		"[INFO]   scripts/\n",
+		"[INFO]     fmt.sh (6 B)\n",
+		"[OK] valid\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestShowCommandReportsModifiedCopyAsJSON(t *testing.T) {
+	tmp := t.TempDir()
+	xdg := filepath.Join(tmp, "xdg")
+	storeSkill := filepath.Join(xdg, "bond", "go")
+	if err := os.MkdirAll(storeSkill, 0o755); err != nil {
+		t.Fatalf("MkdirAll(storeSkill) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("---\nname: go\ndescription: Go\n---\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	projectRoot := filepath.Join(tmp, "project")
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	chdirForTest(t, projectRoot)
+
+	copyCmd := newCopyCmd()
+	copyCmd.SetOut(&bytes.Buffer{})
+	copyCmd.SetErr(&bytes.Buffer{})
+	copyCmd.SetArgs([]string{"go"})
+	if err := copyCmd.Execute(); err != nil {
+		t.Fatalf("Execute(copy) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectRoot, ".agents", "skills", "go", "notes.md"), []byte("local\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(notes.md) error = %v", err)
+	}
+
+	withOutputFormat(t, outputFormatJSON)
+	buf := &bytes.Buffer{}
+	cmd := newShowCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"go"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute(show) error = %v", err)
+	}
+	if err := flushRecords(buf); err != nil {
+		t.Fatalf("flushRecords() error = %v", err)
+	}
+
+	var records []showRecord
+	if err := json.Unmarshal(buf.Bytes(), &records); err != nil {
+		t.Fatalf("Unmarshal(%q) error = %v", buf.String(), err)
+	}
+	if len(records) != 1 || records[0].State != showStateModified || records[0].Source != storeSkill || records[0].Metadata.Description != "Go" {
+		t.Fatalf("records = %+v, want modified copy of %s", records, storeSkill)
+	}
+}
+
+func TestShowCommandSuggestsNamesAndRejectsAmbiguousSelectors(t *testing.T) {
+	storeSkill, _ := setupGoSkillProject(t)
+	gopls := filepath.Join(filepath.Dir(storeSkill), "gopls")
+	mustMkdirAll(t, gopls)
+	if err := os.WriteFile(filepath.Join(gopls, "SKILL.md"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile(gopls) error = %v", err)
+	}
+
+	_, err := executeRootForTest(t, "show", "--store", "gp")
+	if err == nil || !strings.HasSuffix(err.Error(), "(did you mean go?)") {
+		t.Fatalf("Execute(show gp) error = %v, want did-you-mean suggestion", err)
+	}
+
+	_, err = executeRootForTest(t, "show", "--store", "go*")
+	if err == nil || err.Error() != `"go*" matches several skills (go, gopls); name one` {
+		t.Fatalf("Execute(show go*) error = %v, want ambiguous selector error", err)
+	}
+}
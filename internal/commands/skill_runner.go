@@ -1,9 +1,6 @@
 package commands
 
 import (
-	"fmt"
-	"strings"
-
 	"bond/internal/skills"
 	"github.com/spf13/cobra"
 )
@@ -11,33 +8,96 @@ import (
 type skillActionOutput struct {
 	level   string
 	message string
+	// status and path populate the structured record for --output json/ndjson.
+	status string
+	path   string
+	// target names the project target when several are configured.
+	target string
+	// ops lists the operations a dry run would perform.
+	ops []skills.Operation
+	// commit records bookkeeping, such as bond.lock provenance, once the
+	// filesystem change is applied. It never runs in dry-run mode.
+	commit func() error
+}
+
+// skillActionRecord is the structured form of one per-skill action result.
+type skillActionRecord struct {
+	Name    string `json:"name"`
+	Path    string `json:"path,omitempty"`
+	Action  string `json:"action"`
+	Status  string `json:"status"`
+	Target  string `json:"target,omitempty"`
+	Level   string `json:"level"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+	// DryRun and Operations describe what a dry run would have done.
+	DryRun     bool               `json:"dry_run,omitempty"`
+	Operations []skills.Operation `json:"operations,omitempty"`
 }
 
-// runDiscoveredSkillActions maps args to discovered skills and executes one action per match.
+// runDiscoveredSkillActions resolves selectors against discovered skills and executes one action per match.
 func runDiscoveredSkillActions(
 	cmd *cobra.Command,
 	discovered []skills.Skill,
 	args []string,
+	opts selectorOptions,
 	action func(skill skills.Skill) (skillActionOutput, error),
 ) error {
-	selected := selectSkills(discovered, args)
-	if len(selected) == 0 {
-		return fmt.Errorf("no matching skills: %s", strings.Join(args, ", "))
+	single := []projectTarget{{}}
+	return runTargetSkillActions(cmd, discovered, args, batchOptions{selectors: opts}, single, func(skill skills.Skill, _ projectTarget) (skillActionOutput, error) {
+		return action(skill)
+	})
+}
+
+// runTargetSkillActions resolves selectors against discovered skills and executes one action
+// per match and target. Output names the target only when there are several.
+func runTargetSkillActions(
+	cmd *cobra.Command,
+	discovered []skills.Skill,
+	args []string,
+	opts batchOptions,
+	targets []projectTarget,
+	action func(skill skills.Skill, target projectTarget) (skillActionOutput, error),
+) error {
+	selected, err := resolveSelectors(discovered, args, opts.selectors)
+	if err != nil {
+		return err
+	}
+	if opts.atomic {
+		return runAtomicSkillActions(cmd, selected, targets, action)
 	}
+	return runSkillActions(cmd, selected, targets, action)
+}
 
+// runSkillActions executes one action per skill and target and reports each
+// result as it happens. A failure does not stop the remaining actions.
+func runSkillActions(
+	cmd *cobra.Command,
+	selected []skills.Skill,
+	targets []projectTarget,
+	action func(skill skills.Skill, target projectTarget) (skillActionOutput, error),
+) error {
 	var hardErrs int
 	for _, skill := range selected {
-		output, err := action(skill)
-		if err != nil {
-			hardErrs++
-			if printErrErr := printErr(cmd, levelError, "%s: %v", skill.Name, err); printErrErr != nil {
-				return printErrErr
+		for _, target := range targets {
+			targetName := actionTargetName(targets, target)
+
+			output, err := action(skill, target)
+			if err == nil && output.commit != nil && !dryRun {
+				err = output.commit()
+			}
+			if err != nil {
+				hardErrs++
+				if printErrErr := printSkillActionError(cmd, skill.Name, targetName, err); printErrErr != nil {
+					return printErrErr
+				}
+				continue
 			}
-			continue
-		}
 
-		if err := printOut(cmd, output.level, "%s", output.message); err != nil {
-			return err
+			output.target = targetName
+			if err := printSkillAction(cmd, skill.Name, output); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -46,3 +106,53 @@ func runDiscoveredSkillActions(
 	}
 	return nil
 }
+
+// actionTargetName names target in output, or is empty when there is only one.
+func actionTargetName(targets []projectTarget, target projectTarget) string {
+	if len(targets) > 1 {
+		return target.name
+	}
+	return ""
+}
+
+// printSkillAction writes one action result as a text line or structured record.
+func printSkillAction(cmd *cobra.Command, name string, output skillActionOutput) error {
+	record := skillActionRecord{
+		Name:    name,
+		Path:    output.path,
+		Action:  cmd.Name(),
+		Status:  output.status,
+		Target:  output.target,
+		Level:   output.level,
+		Message: output.message,
+	}
+	suffix := ""
+	if dryRun {
+		record.DryRun = true
+		record.Operations = output.ops
+		suffix = dryRunSuffix(output.ops)
+	}
+	if output.target != "" {
+		return printResult(cmd, output.level, record, "%s in %s%s", output.message, output.target, suffix)
+	}
+	return printResult(cmd, output.level, record, "%s%s", output.message, suffix)
+}
+
+// printSkillActionError reports a per-skill failure on stderr, or as an error record.
+// target is empty unless several targets are configured.
+func printSkillActionError(cmd *cobra.Command, name, target string, err error) error {
+	if structuredOutput() {
+		return writeRecord(cmd.OutOrStdout(), skillActionRecord{
+			Name:   name,
+			Action: cmd.Name(),
+			Status: "error",
+			Target: target,
+			Level:  levelError,
+			Error:  err.Error(),
+		})
+	}
+	if target != "" {
+		return printErr(cmd, levelError, "%s in %s: %v", name, target, err)
+	}
+	return printErr(cmd, levelError, "%s: %v", name, err)
+}
@@ -21,7 +21,7 @@ func TestRunDiscoveredSkillActionsReturnsNoMatchingSkillsError(t *testing.T) {
 	cmd.SetErr(stderr)
 
 	discovered := []skills.Skill{{Name: "go", Path: "/tmp/go"}}
-	err := runDiscoveredSkillActions(cmd, discovered, []string{"missing"}, func(skill skills.Skill) (skillActionOutput, error) {
+	err := runDiscoveredSkillActions(cmd, discovered, []string{"missing"}, selectorOptions{}, func(skill skills.Skill) (skillActionOutput, error) {
 		t.Fatalf("action called unexpectedly for skill %+v", skill)
 		return skillActionOutput{}, nil
 	})
@@ -54,7 +54,7 @@ func TestRunDiscoveredSkillActionsReturnsAlreadyReportedFailureForHardErrors(t *
 		{Name: "beta", Path: "/tmp/beta"},
 	}
 
-	err := runDiscoveredSkillActions(cmd, discovered, []string{"alpha", "beta"}, func(skill skills.Skill) (skillActionOutput, error) {
+	err := runDiscoveredSkillActions(cmd, discovered, []string{"alpha", "beta"}, selectorOptions{}, func(skill skills.Skill) (skillActionOutput, error) {
 		if skill.Name == "beta" {
 			return skillActionOutput{}, errors.New("boom")
 		}
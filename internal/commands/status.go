@@ -12,7 +12,7 @@ import (
 func newStatusCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "status",
-		Short: "Show project skill link status",
+		Short: "Show project skill link, copy and adapter output status",
 		Args:  cobra.NoArgs,
 		RunE:  runStatus,
 	}
@@ -20,28 +20,84 @@ func newStatusCmd() *cobra.Command {
 
 // runStatus inspects project skill entries and prints status details.
 func runStatus(cmd *cobra.Command, args []string) error {
-	storeDir, err := config.StoreSkillsDir()
+	stores, err := configuredStores()
 	if err != nil {
 		return err
 	}
 
-	projectDir, err := config.ProjectSkillsDir()
+	targets, err := projectTargets()
 	if err != nil {
 		return err
 	}
-
-	report, err := skills.InspectStatus(storeDir, projectDir)
+	lockPath, err := config.ProjectLockFile()
+	if err != nil {
+		return err
+	}
+	lock, err := skills.LoadLockfile(lockPath)
 	if err != nil {
 		return err
 	}
 
-	if err := printOut(cmd, levelInfo, "project %s", report.ProjectSkillsDir); err != nil {
+	for i, target := range targets {
+		report, err := skills.InspectProjectStatus(stores, target.dir, lock)
+		if err != nil {
+			return err
+		}
+		if err := printStatusReport(cmd, report, i == 0); err != nil {
+			return err
+		}
+	}
+	return printOutputStatus(cmd)
+}
+
+// adapterOutputsRecord is the structured form of the adapter output report.
+type adapterOutputsRecord struct {
+	Outputs []skills.OutputStatusEntry `json:"outputs"`
+}
+
+// printOutputStatus reports adapter outputs tracked in bond.lock, if any.
+func printOutputStatus(cmd *cobra.Command) error {
+	root, err := config.ProjectRoot()
+	if err != nil {
+		return err
+	}
+	lock, err := skills.LoadLockfile(config.ProjectLockFrom(root))
+	if err != nil {
 		return err
 	}
-	if err := printOut(cmd, levelInfo, "store %s", report.StoreSkillsDir); err != nil {
+	if len(lock.Outputs) == 0 {
+		return nil
+	}
+
+	entries, err := skills.InspectOutputs(root, lock)
+	if err != nil {
 		return err
 	}
+	if structuredOutput() {
+		return writeRecord(cmd.OutOrStdout(), adapterOutputsRecord{Outputs: entries})
+	}
+
+	for _, entry := range entries {
+		if err := printOut(cmd, outputStatusLevel(entry.Status), "%s %s (%s %s)", entry.Status, entry.Skill, entry.Adapter, entry.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+func outputStatusLevel(status skills.OutputStatus) string {
+	switch status {
+	case skills.OutputStatusCurrent:
+		return levelOK
+	case skills.OutputStatusMissing:
+		return levelError
+	default:
+		return levelWarn
+	}
+}
+
+// printStatusReport writes one target's report; showStore adds the store header line.
+func printStatusReport(cmd *cobra.Command, report skills.StatusReport, showStore bool) error {
 	entries := append([]skills.StatusEntry(nil), report.Entries...)
 	sort.Slice(entries, func(i, j int) bool {
 		ri := statusRank(entries[i].Status)
@@ -52,6 +108,20 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		return entries[i].Name < entries[j].Name
 	})
 
+	if structuredOutput() {
+		report.Entries = entries
+		return writeRecord(cmd.OutOrStdout(), report)
+	}
+
+	if err := printOut(cmd, levelInfo, "project %s", report.ProjectSkillsDir); err != nil {
+		return err
+	}
+	if showStore {
+		if err := printOut(cmd, levelInfo, "store %s", report.StoreSkillsDir); err != nil {
+			return err
+		}
+	}
+
 	for _, entry := range entries {
 		if err := printOut(cmd, statusLevel(entry.Status), "%s %s", entry.Status, entry.Name); err != nil {
 			return err
@@ -62,11 +132,11 @@ func runStatus(cmd *cobra.Command, args []string) error {
 
 func statusLevel(status skills.StatusKind) string {
 	switch status {
-	case skills.StatusLinked:
+	case skills.StatusLinked, skills.StatusCopied:
 		return levelOK
 	case skills.StatusBroken:
 		return levelError
-	case skills.StatusExternal:
+	case skills.StatusExternal, skills.StatusModified, skills.StatusOutdated, skills.StatusConflict:
 		return levelWarn
 	default:
 		return levelInfo
@@ -81,7 +151,13 @@ func statusRank(status skills.StatusKind) int {
 		return 1
 	case skills.StatusExternal:
 		return 2
-	default:
+	case skills.StatusCopied:
 		return 3
+	case skills.StatusModified, skills.StatusOutdated:
+		return 4
+	case skills.StatusConflict:
+		return 5
+	default:
+		return 6
 	}
 }
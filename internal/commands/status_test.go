@@ -60,3 +60,54 @@ func TestStatusCommandPrintsEntries(t *testing.T) {
 		t.Fatalf("output missing linked entry: %q", output)
 	}
 }
+
+func TestStatusCommandReportsCopyState(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+
+	if _, err := executeRootForTest(t, "copy", "go"); err != nil {
+		t.Fatalf("Execute(copy) error = %v", err)
+	}
+	output, err := executeRootForTest(t, "status")
+	if err != nil {
+		t.Fatalf("Execute(status) error = %v", err)
+	}
+	if !strings.Contains(output, "[OK] copied go\n") {
+		t.Fatalf("output missing copied entry: %q", output)
+	}
+
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("newer"), 0o644); err != nil {
+		t.Fatalf("WriteFile(store SKILL.md) error = %v", err)
+	}
+	mustMkdirAll(t, filepath.Join(projectRoot, ".agents", "skills", "local"))
+	output, err = executeRootForTest(t, "status")
+	if err != nil {
+		t.Fatalf("Execute(status) error = %v", err)
+	}
+	if !strings.Contains(output, "[WARN] outdated go\n") || !strings.Contains(output, "[INFO] project_only local\n") {
+		t.Fatalf("output = %q, want outdated go and project_only local", output)
+	}
+}
+
+func TestStatusCommandSkipsIndexFiles(t *testing.T) {
+	setupGoSkillProject(t)
+
+	if _, err := executeRootForTest(t, "link", "go"); err != nil {
+		t.Fatalf("Execute(link) error = %v", err)
+	}
+	for _, format := range []string{"markdown", "json"} {
+		if _, err := executeRootForTest(t, "index", "--format", format); err != nil {
+			t.Fatalf("Execute(index --format %s) error = %v", format, err)
+		}
+	}
+
+	output, err := executeRootForTest(t, "status")
+	if err != nil {
+		t.Fatalf("Execute(status) error = %v", err)
+	}
+	if strings.Contains(output, "INDEX.md") || strings.Contains(output, "index.json") {
+		t.Fatalf("output = %q, want index files left out", output)
+	}
+	if !strings.Contains(output, "[OK] linked go") {
+		t.Fatalf("output = %q, want linked go", output)
+	}
+}
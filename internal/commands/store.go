@@ -2,7 +2,10 @@ package commands
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"bond/internal/config"
 	"bond/internal/skills"
@@ -11,20 +14,42 @@ import (
 
 // newStoreCmd builds the command that stores project skills in the store directory.
 func newStoreCmd() *cobra.Command {
+	var merge bool
+	var replace bool
+	var selectors selectorOptions
+
 	cmd := &cobra.Command{
-		Use:   "store [skill ...]",
-		Short: "Copy project skills into the store Bond directory",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  runStore,
+		Use:         "store [selector ...]",
+		Short:       "Copy project skills into the store Bond directory",
+		Long:        "Store copies project skills into the primary store. " + selectorHelp,
+		Annotations: dryRunAnnotations(dryRunSupported),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStore(cmd, args, storeOptions{merge: merge, replace: replace, selectors: selectors})
+		},
 	}
 
+	cmd.Flags().BoolVar(&merge, "merge", false, "Three-way merge edited copies into existing store skills")
+	cmd.Flags().BoolVar(&replace, "replace", false, "Replace existing store skills with the project version, keeping a backup")
+	cmd.MarkFlagsMutuallyExclusive("merge", "replace")
+	addSelectorFlags(cmd, &selectors)
 	cmd.ValidArgsFunction = completeProjectStorableSkills
 	return cmd
 }
 
+// storeOptions selects how runStore handles skills that already exist in the store.
+type storeOptions struct {
+	merge     bool
+	replace   bool
+	selectors selectorOptions
+}
+
 // runStore executes copy operations from project-local skills to store skills.
-func runStore(cmd *cobra.Command, args []string) error {
-	projectSkillsDir, err := config.ProjectSkillsDir()
+func runStore(cmd *cobra.Command, args []string, opts storeOptions) error {
+	if dryRun && opts.merge {
+		return fmt.Errorf("--dry-run cannot preview --merge")
+	}
+
+	projectSkillsDir, err := primarySkillsDir()
 	if err != nil {
 		return err
 	}
@@ -33,7 +58,7 @@ func runStore(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	if _, err := ensureDir(storeDir); err != nil {
+	if err := prepareDir(cmd, storeDir); err != nil {
 		return err
 	}
 
@@ -42,27 +67,167 @@ func runStore(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return runDiscoveredSkillActions(cmd, discovered, args, func(skill skills.Skill) (skillActionOutput, error) {
+	var updater *storeUpdater
+	if opts.merge || opts.replace {
+		updater, err = newStoreUpdater(storeDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	runErr := runDiscoveredSkillActions(cmd, discovered, args, opts.selectors, func(skill skills.Skill) (skillActionOutput, error) {
 		dest := filepath.Join(storeDir, skill.Name)
-		result, err := skills.Copy(skill.Path, dest)
+		result, ops, err := copySkill(skill.Path, dest)
 		if err != nil {
 			return skillActionOutput{}, err
 		}
 
 		switch result.Status {
 		case skills.CopyStatusCopied:
-			return skillActionOutput{level: levelOK, message: fmt.Sprintf("stored %s", skill.Name)}, nil
+			return skillActionOutput{level: levelOK, message: fmt.Sprintf("stored %s", skill.Name), status: string(result.Status), path: dest, ops: ops}, nil
 		case skills.CopyStatusConflict:
-			return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (already exists)", skill.Name)}, nil
+			switch {
+			case opts.merge:
+				return updater.merge(skill)
+			case opts.replace:
+				return updater.replace(skill)
+			}
+			return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (already exists)", skill.Name), status: string(result.Status), path: dest}, nil
 		default:
 			return skillActionOutput{}, fmt.Errorf("unexpected store status %q for %q", result.Status, skill.Name)
 		}
 	})
+
+	if updater != nil && updater.recorded {
+		if err := skills.SaveLockfile(updater.lockPath, updater.lock); err != nil {
+			return err
+		}
+	}
+	if runErr == nil && updater != nil && updater.conflicts > 0 {
+		return alreadyReportedFailure()
+	}
+	return runErr
+}
+
+// storeUpdater merges or replaces existing store skills from edited project copies.
+type storeUpdater struct {
+	storeByName map[string]skills.Skill
+	backupDir   string
+	baseDir     string
+	lockPath    string
+	lock        skills.Lockfile
+	recorded    bool
+	// conflicts counts merges left for the user to resolve in the project.
+	conflicts int
+}
+
+func newStoreUpdater(storeDir string) (*storeUpdater, error) {
+	storeSkills, err := skills.Discover(storeDir)
+	if err != nil {
+		return nil, err
+	}
+	storeByName := make(map[string]skills.Skill, len(storeSkills))
+	for _, skill := range storeSkills {
+		storeByName[skill.Name] = skill
+	}
+
+	baseDir, err := config.ProjectBaseDir()
+	if err != nil {
+		return nil, err
+	}
+	lockPath, err := config.ProjectLockFile()
+	if err != nil {
+		return nil, err
+	}
+	lock, err := skills.LoadLockfile(lockPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storeUpdater{
+		storeByName: storeByName,
+		backupDir:   config.StoreBackupDirFrom(storeDir),
+		baseDir:     baseDir,
+		lockPath:    lockPath,
+		lock:        lock,
+	}, nil
+}
+
+// source returns the store skill to merge into or replace: the bond.lock
+// source of the copy, or the primary store skill of the same name when the
+// copy has no record. A recorded source that no longer exists is an error.
+func (m *storeUpdater) source(name string) (skills.Skill, bool, error) {
+	skill, ok, err := copySource(m.storeByName, m.lock, name)
+	if err == nil && !ok {
+		if _, recorded := m.lock.Skills[name]; recorded {
+			return skills.Skill{}, false, fmt.Errorf("recorded store source %q no longer exists", m.lock.Skills[name].Source)
+		}
+	}
+	return skill, ok, err
+}
+
+// merge folds project changes into the store skill using the recorded copy as base.
+// A clean merge also refreshes the project copy so both sides match again; a
+// conflicted one is written to the project copy and leaves the store alone.
+func (m *storeUpdater) merge(skill skills.Skill) (skillActionOutput, error) {
+	storeSkill, ok, err := m.source(skill.Name)
+	if err != nil {
+		return skillActionOutput{}, err
+	}
+	if !ok {
+		// The conflicting store entry is not a skill directory, so there is nothing to merge into.
+		return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (already exists)", skill.Name), status: string(skills.CopyStatusConflict)}, nil
+	}
+
+	basePath := filepath.Join(m.baseDir, skill.Name)
+	if _, err := os.Stat(basePath); err != nil {
+		if os.IsNotExist(err) {
+			return skillActionOutput{}, fmt.Errorf("no recorded base copy; run bond copy or bond update before merging")
+		}
+		return skillActionOutput{}, err
+	}
+
+	result, err := skills.Merge(basePath, skill.Path, storeSkill.Path)
+	if err != nil {
+		return skillActionOutput{}, err
+	}
+
+	if len(result.Conflicts) > 0 {
+		// The copy now holds the store changes too, so the store becomes its
+		// base; once the conflicts are resolved, the next merge applies cleanly.
+		if err := m.lock.RecordCopy(skill.Name, storeSkill.Path, storeSkill.Path, time.Now()); err != nil {
+			return skillActionOutput{}, err
+		}
+		if err := skills.SnapshotBase(storeSkill.Path, m.baseDir, skill.Name); err != nil {
+			return skillActionOutput{}, err
+		}
+		m.recorded = true
+		m.conflicts++
+		return skillActionOutput{
+			level:   levelWarn,
+			message: fmt.Sprintf("merged %s with conflicts in the project copy: %s (resolve them and rerun bond store --merge)", skill.Name, strings.Join(result.Conflicts, ", ")),
+			status:  "merge_conflict",
+			path:    skill.Path,
+		}, nil
+	}
+
+	if err := skills.Replace(storeSkill.Path, skill.Path, ""); err != nil {
+		return skillActionOutput{}, err
+	}
+	if err := recordCopy(&m.lock, m.baseDir, skill.Name, storeSkill.Path, skill.Path); err != nil {
+		return skillActionOutput{}, err
+	}
+	m.recorded = true
+
+	if !result.Changed {
+		return skillActionOutput{level: levelInfo, message: fmt.Sprintf("nothing to merge %s", skill.Name), status: "unchanged", path: storeSkill.Path}, nil
+	}
+	return skillActionOutput{level: levelOK, message: fmt.Sprintf("merged %s", skill.Name), status: "merged", path: storeSkill.Path}, nil
 }
 
 // completeProjectStorableSkills offers shell completions from project-local storable skills.
 func completeProjectStorableSkills(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	projectSkillsDir, err := config.ProjectSkillsDir()
+	projectSkillsDir, err := primarySkillsDir()
 	if err != nil {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	}
@@ -79,3 +244,47 @@ func completeProjectStorableSkills(cmd *cobra.Command, args []string, toComplete
 
 	return candidates, cobra.ShellCompDirectiveNoFileComp
 }
+
+// replace swaps the store skill for the project version and keeps the old store
+// version in a timestamped backup directory.
+func (m *storeUpdater) replace(skill skills.Skill) (skillActionOutput, error) {
+	storeSkill, ok, err := m.source(skill.Name)
+	if err != nil {
+		return skillActionOutput{}, err
+	}
+	if !ok {
+		return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (already exists)", skill.Name), status: string(skills.CopyStatusConflict)}, nil
+	}
+
+	backupPath, err := uniqueBackupPath(m.backupDir, skill.Name, time.Now())
+	if err != nil {
+		return skillActionOutput{}, err
+	}
+	if dryRun {
+		op := skills.Operation{Kind: skills.OpReplace, Path: storeSkill.Path, Source: skill.Path, Backup: backupPath}
+		return skillActionOutput{level: levelOK, message: fmt.Sprintf("replaced %s", skill.Name), status: "replaced", path: storeSkill.Path, ops: []skills.Operation{op}}, nil
+	}
+	if err := skills.Replace(skill.Path, storeSkill.Path, backupPath); err != nil {
+		return skillActionOutput{}, err
+	}
+	if err := recordCopy(&m.lock, m.baseDir, skill.Name, storeSkill.Path, skill.Path); err != nil {
+		return skillActionOutput{}, err
+	}
+	m.recorded = true
+
+	return skillActionOutput{level: levelOK, message: fmt.Sprintf("replaced %s (backup %s)", skill.Name, backupPath), status: "replaced", path: storeSkill.Path}, nil
+}
+
+// uniqueBackupPath returns an unused <name>-<UTC timestamp> path in backupDir.
+func uniqueBackupPath(backupDir, name string, now time.Time) (string, error) {
+	stamp := name + "-" + now.UTC().Format("20060102T150405Z")
+	candidate := filepath.Join(backupDir, stamp)
+	for i := 1; ; i++ {
+		if _, err := os.Lstat(candidate); os.IsNotExist(err) {
+			return candidate, nil
+		} else if err != nil {
+			return "", err
+		}
+		candidate = filepath.Join(backupDir, fmt.Sprintf("%s-%d", stamp, i))
+	}
+}
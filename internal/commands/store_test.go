@@ -272,3 +272,216 @@ func TestCompleteProjectStorableSkillsReturnsNoCandidatesOnDiscoverError(t *test
 		t.Fatalf("len(candidates) = %d, want 0", len(candidates))
 	}
 }
+
+func TestStoreCommandMergeCombinesProjectAndStoreEdits(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	projectSkill := filepath.Join(projectRoot, ".agents", "skills", "go")
+	xdgConfig := filepath.Join(tmp, "xdg")
+	storeSkill := filepath.Join(xdgConfig, "bond", "go")
+
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	if err := os.MkdirAll(storeSkill, 0o755); err != nil {
+		t.Fatalf("MkdirAll(storeSkill) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("intro\nbody\noutro\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(store SKILL.md) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", xdgConfig)
+
+	copyCmd := newCopyCmd()
+	copyCmd.SetOut(&bytes.Buffer{})
+	copyCmd.SetErr(&bytes.Buffer{})
+	copyCmd.SetArgs([]string{"go"})
+	if err := copyCmd.Execute(); err != nil {
+		t.Fatalf("copy Execute() error = %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(projectSkill, "SKILL.md"), []byte("project intro\nbody\noutro\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(project SKILL.md) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("intro\nbody\nstore outro\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(store SKILL.md) error = %v", err)
+	}
+
+	buf := &bytes.Buffer{}
+	cmd := newStoreCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"--merge", "go"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "[OK] merged go\n") {
+		t.Fatalf("output missing merged line: %q", got)
+	}
+
+	want := "project intro\nbody\nstore outro\n"
+	for _, path := range []string{storeSkill, projectSkill} {
+		got, err := os.ReadFile(filepath.Join(path, "SKILL.md"))
+		if err != nil {
+			t.Fatalf("ReadFile(%s/SKILL.md) error = %v", path, err)
+		}
+		if string(got) != want {
+			t.Fatalf("%s/SKILL.md = %q, want %q", path, string(got), want)
+		}
+	}
+}
+
+func TestStoreCommandMergeReportsConflicts(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	projectSkill := filepath.Join(projectRoot, ".agents", "skills", "go")
+	xdgConfig := filepath.Join(tmp, "xdg")
+	storeSkill := filepath.Join(xdgConfig, "bond", "go")
+
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	if err := os.MkdirAll(storeSkill, 0o755); err != nil {
+		t.Fatalf("MkdirAll(storeSkill) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("body\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(store SKILL.md) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", xdgConfig)
+
+	copyCmd := newCopyCmd()
+	copyCmd.SetOut(&bytes.Buffer{})
+	copyCmd.SetErr(&bytes.Buffer{})
+	copyCmd.SetArgs([]string{"go"})
+	if err := copyCmd.Execute(); err != nil {
+		t.Fatalf("copy Execute() error = %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(projectSkill, "SKILL.md"), []byte("project\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(project SKILL.md) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("store\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(store SKILL.md) error = %v", err)
+	}
+
+	buf := &bytes.Buffer{}
+	cmd := newStoreCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"--merge", "go"})
+
+	if err := cmd.Execute(); !IsAlreadyReportedFailure(err) {
+		t.Fatalf("Execute() error = %v, want already-reported failure", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "[WARN] merged go with conflicts in the project copy: SKILL.md (resolve them and rerun bond store --merge)\n") {
+		t.Fatalf("output missing conflict line: %q", got)
+	}
+
+	got, err := os.ReadFile(filepath.Join(projectSkill, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile(project SKILL.md) error = %v", err)
+	}
+	if want := "<<<<<<< project\nproject\n=======\nstore\n>>>>>>> store\n"; string(got) != want {
+		t.Fatalf("project SKILL.md = %q, want %q", string(got), want)
+	}
+	if got, err := os.ReadFile(filepath.Join(storeSkill, "SKILL.md")); err != nil || string(got) != "store\n" {
+		t.Fatalf("store SKILL.md = %q, %v; want untouched until the merge is clean", got, err)
+	}
+
+	// Once resolved in the project, the merge applies cleanly.
+	if err := os.WriteFile(filepath.Join(projectSkill, "SKILL.md"), []byte("project\nstore\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(project SKILL.md) error = %v", err)
+	}
+	buf.Reset()
+	cmd = newStoreCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"--merge", "go"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("second Execute() error = %v", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "[OK] merged go\n") {
+		t.Fatalf("second output missing merged line: %q", got)
+	}
+	if got, err := os.ReadFile(filepath.Join(storeSkill, "SKILL.md")); err != nil || string(got) != "project\nstore\n" {
+		t.Fatalf("store SKILL.md = %q, %v; want resolved version", got, err)
+	}
+}
+
+func TestStoreCommandReplaceKeepsBackup(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	projectSkill := filepath.Join(projectRoot, ".agents", "skills", "go")
+	xdgConfig := filepath.Join(tmp, "xdg")
+	storeDir := filepath.Join(xdgConfig, "bond")
+	storeSkill := filepath.Join(storeDir, "go")
+
+	if err := os.MkdirAll(projectSkill, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectSkill) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectSkill, "SKILL.md"), []byte("project"), 0o644); err != nil {
+		t.Fatalf("WriteFile(project SKILL.md) error = %v", err)
+	}
+	if err := os.MkdirAll(storeSkill, 0o755); err != nil {
+		t.Fatalf("MkdirAll(storeSkill) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("store"), 0o644); err != nil {
+		t.Fatalf("WriteFile(store SKILL.md) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", xdgConfig)
+
+	buf := &bytes.Buffer{}
+	cmd := newStoreCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"--replace", "go"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "[OK] replaced go (backup ") {
+		t.Fatalf("output missing replaced line: %q", got)
+	}
+
+	got, err := os.ReadFile(filepath.Join(storeSkill, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile(store SKILL.md) error = %v", err)
+	}
+	if string(got) != "project" {
+		t.Fatalf("store SKILL.md = %q, want project", string(got))
+	}
+
+	backups, err := filepath.Glob(filepath.Join(storeDir, ".bond", "backups", "go-*", "SKILL.md"))
+	if err != nil {
+		t.Fatalf("Glob(backups) error = %v", err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("backups = %v, want one backup", backups)
+	}
+	backup, err := os.ReadFile(backups[0])
+	if err != nil {
+		t.Fatalf("ReadFile(backup) error = %v", err)
+	}
+	if string(backup) != "store" {
+		t.Fatalf("backup SKILL.md = %q, want store", string(backup))
+	}
+}
+
+func TestStoreCommandRejectsMergeWithReplace(t *testing.T) {
+	cmd := newStoreCmd()
+	cmd.SetArgs([]string{"--merge", "--replace", "go"})
+
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatal("Execute() error = nil, want mutually exclusive flag error")
+	}
+	if !strings.Contains(err.Error(), "none of the others can be") {
+		t.Fatalf("Execute() error = %q, want mutually exclusive flag message", err)
+	}
+}
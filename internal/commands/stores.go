@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+)
+
+// configuredStores returns the store search path in priority order.
+func configuredStores() ([]skills.StoreDir, error) {
+	stores, err := config.Stores()
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := make([]skills.StoreDir, 0, len(stores))
+	for _, store := range stores {
+		dirs = append(dirs, skills.StoreDir{Name: store.Name, Path: store.Path})
+	}
+	return dirs, nil
+}
+
+// discoverStoreSkills returns visible skills followed by shadowed ones, so
+// name-only selection resolves to the highest-priority store while
+// store:skill can still reach a shadowed skill.
+func discoverStoreSkills(stores []skills.StoreDir) ([]skills.Skill, error) {
+	visible, shadowed, err := skills.DiscoverStores(stores)
+	if err != nil {
+		return nil, err
+	}
+	return append(visible, shadowed...), nil
+}
+
+// visibleStoreSkills indexes the highest-priority skill for each name.
+func visibleStoreSkills(stores []skills.StoreDir) (map[string]skills.Skill, error) {
+	visible, _, err := skills.DiscoverStores(stores)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]skills.Skill, len(visible))
+	for _, skill := range visible {
+		byName[skill.Name] = skill
+	}
+	return byName, nil
+}
+
+// copySource returns the store skill the project copy name was made from.
+// The bond.lock source wins, so a copy of a shadowed skill keeps following
+// that skill; copies without a record fall back to the visible store skill.
+// ok is false when the recorded source no longer exists.
+func copySource(visible map[string]skills.Skill, lock skills.Lockfile, name string) (skills.Skill, bool, error) {
+	entry, recorded := lock.Skills[name]
+	if !recorded {
+		skill, ok := visible[name]
+		return skill, ok, nil
+	}
+
+	info, err := os.Stat(entry.Source)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return skills.Skill{}, false, nil
+		}
+		return skills.Skill{}, false, err
+	}
+	if !info.IsDir() {
+		return skills.Skill{}, false, nil
+	}
+	return skills.Skill{Name: name, Path: entry.Source}, true, nil
+}
+
+// errCopySourceMissing reports a copy whose store skill cannot be found.
+func errCopySourceMissing(stores []skills.StoreDir, lock skills.Lockfile, name string) error {
+	if entry, ok := lock.Skills[name]; ok {
+		return fmt.Errorf("recorded store source %q no longer exists", entry.Source)
+	}
+	return errSkillNotInStores(stores)
+}
+
+// discoverLinkedStores returns project symlinks that point into any store.
+func discoverLinkedStores(projectSkillsDir string, stores []skills.StoreDir) ([]skills.Entry, error) {
+	linked := []skills.Entry{}
+	seen := map[string]struct{}{}
+	for _, store := range stores {
+		entries, err := skills.DiscoverProjectLinkedStore(projectSkillsDir, store.Path)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if _, exists := seen[entry.Name]; exists {
+				continue
+			}
+			seen[entry.Name] = struct{}{}
+			linked = append(linked, entry)
+		}
+	}
+
+	sort.Slice(linked, func(i, j int) bool { return linked[i].Name < linked[j].Name })
+	return linked, nil
+}
+
+// resolveStoreSkill selects one store skill by name or store:skill.
+func resolveStoreSkill(stores []skills.StoreDir, name string) (skills.Skill, error) {
+	discovered, err := discoverStoreSkills(stores)
+	if err != nil {
+		return skills.Skill{}, err
+	}
+	return selectOneSkill(discovered, name, fmt.Errorf("%s: %w", name, errSkillNotInStores(stores)))
+}
+
+// storeFor returns the store skill was discovered in.
+func storeFor(stores []skills.StoreDir, skill skills.Skill) (skills.StoreDir, error) {
+	for _, store := range stores {
+		if store.Name == skill.Store {
+			return store, nil
+		}
+	}
+	return skills.StoreDir{}, fmt.Errorf("%s: store %q is not configured", skill.Name, skill.Store)
+}
+
+// errSkillNotInStores reports a skill missing from every configured store.
+func errSkillNotInStores(stores []skills.StoreDir) error {
+	if len(stores) == 1 {
+		return fmt.Errorf("skill not found in store directory %q", stores[0].Path)
+	}
+
+	names := make([]string, 0, len(stores))
+	for _, store := range stores {
+		names = append(names, store.Name)
+	}
+	return fmt.Errorf("skill not found in stores: %s", strings.Join(names, ", "))
+}
+
+// qualifiedSkillName returns the store:skill selector for skill.
+func qualifiedSkillName(skill skills.Skill) string {
+	return skill.Store + ":" + skill.Name
+}
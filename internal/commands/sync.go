@@ -0,0 +1,375 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// newSyncCmd builds the command that reconciles project skills with bond.yaml.
+func newSyncCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "sync",
+		Short:       "Reconcile ./.agents/skills with the bond.yaml manifest",
+		Long:        "Sync links or copies every skill listed in bond.yaml into ./.agents/skills and unlinks store skills that are no longer listed. Entries without a mode use the sync_mode setting, which defaults to link. Adapters listed in bond.yaml also render each skill into their rule formats. Copies are tracked in bond.lock for the first target only; copies in other targets are untracked mirrors that sync leaves alone once they exist.",
+		Args:        cobra.NoArgs,
+		Annotations: dryRunAnnotations(dryRunUnsupported),
+		RunE:        runSync,
+	}
+}
+
+// runSync applies the project manifest and prints per-skill status.
+func runSync(cmd *cobra.Command, args []string) error {
+	manifestPath, err := config.ProjectManifestFile()
+	if err != nil {
+		return err
+	}
+
+	syncMode, err := config.ResolveSetting(config.KeySyncMode)
+	if err != nil {
+		return err
+	}
+	manifest, err := skills.LoadManifest(manifestPath, skills.ManifestMode(syncMode.Value))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no project manifest found at %q", manifestPath)
+		}
+		return err
+	}
+
+	stores, err := configuredStores()
+	if err != nil {
+		return err
+	}
+
+	targets, err := projectTargets()
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if _, err := ensureDir(target.dir); err != nil {
+			return err
+		}
+	}
+
+	byName, err := visibleStoreSkills(stores)
+	if err != nil {
+		return err
+	}
+
+	lockPath, err := config.ProjectLockFile()
+	if err != nil {
+		return err
+	}
+	lock, err := skills.LoadLockfile(lockPath)
+	if err != nil {
+		return err
+	}
+	baseDir, err := config.ProjectBaseDir()
+	if err != nil {
+		return err
+	}
+	lockChanged := false
+
+	var hardErrs int
+	wanted := make(map[string]struct{}, len(manifest.Skills))
+	for _, entry := range manifest.Skills {
+		wanted[entry.Name] = struct{}{}
+
+		for i, target := range targets {
+			targetName := ""
+			if len(targets) > 1 {
+				targetName = target.name
+			}
+
+			// Only the primary target's copies are recorded in bond.lock.
+			targetLock := &lock
+			if i > 0 {
+				targetLock = nil
+			}
+
+			output, err := syncManifestSkill(byName, stores, target.dir, baseDir, entry, targetLock, &lockChanged)
+			if err != nil {
+				hardErrs++
+				if printErrErr := printSkillActionError(cmd, entry.Name, targetName, err); printErrErr != nil {
+					return printErrErr
+				}
+				continue
+			}
+			output.target = targetName
+			if err := printSkillAction(cmd, entry.Name, output); err != nil {
+				return err
+			}
+		}
+	}
+
+	projectRoot, err := config.ProjectRoot()
+	if err != nil {
+		return err
+	}
+	adapterErrs, err := syncAdapterOutputs(cmd, manifest, byName, projectRoot, &lock, &lockChanged)
+	if err != nil {
+		return err
+	}
+	hardErrs += adapterErrs
+
+	// Store links missing from the manifest are pruned so the project matches it exactly.
+	for _, target := range targets {
+		targetName := ""
+		if len(targets) > 1 {
+			targetName = target.name
+		}
+
+		linked, err := discoverLinkedStores(target.dir, stores)
+		if err != nil {
+			return err
+		}
+		for _, entry := range linked {
+			if _, ok := wanted[entry.Name]; ok {
+				continue
+			}
+			removed, _, err := unlinkSkill(entry.Path)
+			if err != nil {
+				hardErrs++
+				if printErrErr := printSkillActionError(cmd, entry.Name, targetName, err); printErrErr != nil {
+					return printErrErr
+				}
+				continue
+			}
+			if removed {
+				output := skillActionOutput{
+					level:   levelOK,
+					message: fmt.Sprintf("unlinked %s (not in manifest)", entry.Name),
+					status:  "unlinked",
+					path:    entry.Path,
+					target:  targetName,
+				}
+				if err := printSkillAction(cmd, entry.Name, output); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if lockChanged {
+		if err := skills.SaveLockfile(lockPath, lock); err != nil {
+			return err
+		}
+	}
+	if err := registerProject(); err != nil {
+		return err
+	}
+
+	if hardErrs > 0 {
+		return alreadyReportedFailure()
+	}
+	return nil
+}
+
+// syncAdapterOutputs renders every manifest skill with every manifest adapter
+// and removes tracked outputs the manifest no longer asks for. It returns the
+// number of reported per-skill failures.
+func syncAdapterOutputs(
+	cmd *cobra.Command,
+	manifest skills.Manifest,
+	byName map[string]skills.Skill,
+	projectRoot string,
+	lock *skills.Lockfile,
+	lockChanged *bool,
+) (int, error) {
+	var hardErrs int
+	wanted := map[string]struct{}{}
+	for _, entry := range manifest.Skills {
+		skill, ok := byName[entry.Name]
+		if !ok {
+			// The missing skill was already reported by the skill pass.
+			continue
+		}
+
+		for _, name := range manifest.Adapters {
+			adapter, _ := skills.LookupAdapter(name)
+			wanted[skills.OutputKey(name, skill.Name)] = struct{}{}
+
+			result, err := skills.RenderOutput(projectRoot, adapter, skill.Path, lock)
+			if err != nil {
+				hardErrs++
+				if printErrErr := printSkillActionError(cmd, skill.Name, "", err); printErrErr != nil {
+					return 0, printErrErr
+				}
+				continue
+			}
+			if result.Status == skills.RenderStatusRendered {
+				*lockChanged = true
+			}
+			if err := printSkillAction(cmd, skill.Name, renderActionOutput(skill.Name, name, result)); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(lock.Outputs))
+	for key := range lock.Outputs {
+		if _, ok := wanted[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		entry := lock.Outputs[key]
+		result, err := skills.RemoveOutput(projectRoot, key, lock)
+		if err != nil {
+			hardErrs++
+			if printErrErr := printSkillActionError(cmd, entry.Skill, "", err); printErrErr != nil {
+				return 0, printErrErr
+			}
+			continue
+		}
+		if result.Status == skills.RenderStatusRemoved {
+			*lockChanged = true
+		}
+		if err := printSkillAction(cmd, entry.Skill, renderActionOutput(entry.Skill, entry.Adapter, result)); err != nil {
+			return 0, err
+		}
+	}
+	return hardErrs, nil
+}
+
+// renderActionOutput describes one adapter output result.
+func renderActionOutput(skill, adapter string, result skills.RenderResult) skillActionOutput {
+	output := skillActionOutput{status: string(result.Status), path: result.Path}
+	switch result.Status {
+	case skills.RenderStatusRendered:
+		output.level, output.message = levelOK, fmt.Sprintf("rendered %s for %s", skill, adapter)
+	case skills.RenderStatusUpToDate:
+		output.level, output.message = levelInfo, fmt.Sprintf("already rendered %s for %s", skill, adapter)
+	case skills.RenderStatusModified:
+		output.level, output.message = levelWarn, fmt.Sprintf("skipped %s for %s (generated output was edited)", skill, adapter)
+	case skills.RenderStatusConflict:
+		output.level, output.message = levelWarn, fmt.Sprintf("skipped %s for %s (%s is not managed by bond)", skill, adapter, result.Path)
+	case skills.RenderStatusRemoved:
+		output.level, output.message = levelOK, fmt.Sprintf("removed %s for %s (not in manifest)", skill, adapter)
+	}
+	return output
+}
+
+// syncManifestSkill materializes one manifest entry in the project skills directory.
+// Copies are recorded in lock, when it is not nil, and flagged through lockChanged.
+// lock is nil for targets after the first: their copies are plain mirrors that
+// status, update and store do not track, so sync only refreshes them by copying anew.
+func syncManifestSkill(
+	byName map[string]skills.Skill,
+	stores []skills.StoreDir,
+	skillsDir, baseDir string,
+	entry skills.ManifestSkill,
+	lock *skills.Lockfile,
+	lockChanged *bool,
+) (skillActionOutput, error) {
+	skill, ok := byName[entry.Name]
+	if !ok {
+		return skillActionOutput{}, errSkillNotInStores(stores)
+	}
+	dest := filepath.Join(skillsDir, skill.Name)
+
+	switch entry.Mode {
+	case skills.ManifestModeLink:
+		result, _, err := linkSkill(skill.Path, dest)
+		if err != nil {
+			return skillActionOutput{}, err
+		}
+
+		switch result.Status {
+		case skills.LinkStatusLinked:
+			return skillActionOutput{level: levelOK, message: fmt.Sprintf("linked %s", skill.Name), status: string(result.Status), path: dest}, nil
+		case skills.LinkStatusAlreadyLinked:
+			return skillActionOutput{level: levelInfo, message: fmt.Sprintf("already linked %s", skill.Name), status: string(result.Status), path: dest}, nil
+		case skills.LinkStatusConflict:
+			return skillActionOutput{level: levelWarn, message: fmt.Sprintf("conflict %s", skill.Name), status: string(result.Status), path: dest}, nil
+		default:
+			return skillActionOutput{}, fmt.Errorf("unexpected link status %q for %q", result.Status, skill.Name)
+		}
+	case skills.ManifestModeCopy:
+		// A store link left over from link mode is replaced by a copy.
+		replacedLink, err := unlinkStoreLink(dest, stores)
+		if err != nil {
+			return skillActionOutput{}, err
+		}
+
+		result, _, err := copySkill(skill.Path, dest)
+		if err != nil {
+			return skillActionOutput{}, err
+		}
+
+		switch result.Status {
+		case skills.CopyStatusCopied:
+			if lock != nil {
+				if err := recordCopy(lock, baseDir, skill.Name, skill.Path, dest); err != nil {
+					return skillActionOutput{}, err
+				}
+				*lockChanged = true
+			}
+			if replacedLink {
+				return skillActionOutput{level: levelOK, message: fmt.Sprintf("copied %s (replaced link)", skill.Name), status: string(result.Status), path: dest}, nil
+			}
+			return skillActionOutput{level: levelOK, message: fmt.Sprintf("copied %s", skill.Name), status: string(result.Status), path: dest}, nil
+		case skills.CopyStatusConflict:
+			copied, err := isSyncedCopy(lock, skill, dest)
+			if err != nil {
+				return skillActionOutput{}, err
+			}
+			if copied {
+				return skillActionOutput{level: levelInfo, message: fmt.Sprintf("already copied %s", skill.Name), status: "already_copied", path: dest}, nil
+			}
+			if info, err := os.Lstat(dest); lock == nil && err == nil && info.IsDir() {
+				return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (differs from the store; copies outside the first target are not tracked, so remove it to copy again)", skill.Name), status: "untracked_copy", path: dest}, nil
+			}
+			return skillActionOutput{level: levelWarn, message: fmt.Sprintf("conflict %s", skill.Name), status: string(result.Status), path: dest}, nil
+		default:
+			return skillActionOutput{}, fmt.Errorf("unexpected copy status %q for %q", result.Status, skill.Name)
+		}
+	default:
+		return skillActionOutput{}, fmt.Errorf("unexpected manifest mode %q", entry.Mode)
+	}
+}
+
+// isSyncedCopy reports whether the directory at dest is a copy of skill: one
+// recorded in lock, or, without a record, one identical to the store skill.
+func isSyncedCopy(lock *skills.Lockfile, skill skills.Skill, dest string) (bool, error) {
+	if info, err := os.Lstat(dest); err != nil || !info.IsDir() {
+		return false, nil
+	}
+	if lock != nil {
+		if _, ok := lock.Skills[skill.Name]; ok {
+			return true, nil
+		}
+	}
+	copied, err := skills.DigestDir(dest)
+	if err != nil {
+		return false, err
+	}
+	source, err := skills.DigestDir(skill.Path)
+	if err != nil {
+		return false, err
+	}
+	return copied == source, nil
+}
+
+// unlinkStoreLink removes dest only when it is a symlink into one of stores.
+func unlinkStoreLink(dest string, stores []skills.StoreDir) (bool, error) {
+	linked, err := discoverLinkedStores(filepath.Dir(dest), stores)
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range linked {
+		if entry.Name == filepath.Base(dest) {
+			removed, _, err := unlinkSkill(entry.Path)
+			return removed, err
+		}
+	}
+	return false, nil
+}
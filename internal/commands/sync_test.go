@@ -0,0 +1,322 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+func TestSyncCommandAppliesManifest(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	projectSkills := filepath.Join(projectRoot, ".agents", "skills")
+	xdgConfig := filepath.Join(tmp, "xdg")
+	storeDir := filepath.Join(xdgConfig, "bond")
+
+	for _, name := range []string{"go", "react", "stale"} {
+		skillDir := filepath.Join(storeDir, name)
+		if err := os.MkdirAll(skillDir, 0o755); err != nil {
+			t.Fatalf("MkdirAll(%s) error = %v", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(skillDir, "SKILL.md"), []byte("---\nname: "+name+"\n---\n"), 0o644); err != nil {
+			t.Fatalf("WriteFile(%s/SKILL.md) error = %v", name, err)
+		}
+	}
+	if err := os.MkdirAll(projectSkills, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectSkills) error = %v", err)
+	}
+	if err := os.Symlink(filepath.Join(storeDir, "stale"), filepath.Join(projectSkills, "stale")); err != nil {
+		t.Fatalf("Symlink(stale) error = %v", err)
+	}
+	manifest := "skills:\n  - name: go\n  - name: react\n    mode: copy\n"
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte(manifest), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", xdgConfig)
+
+	buf := &bytes.Buffer{}
+	cmd := newSyncCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("first Execute() error = %v", err)
+	}
+	got := buf.String()
+	for _, want := range []string{"[OK] linked go\n", "[OK] copied react\n", "[OK] unlinked stale (not in manifest)\n"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("first output missing %q: %q", want, got)
+		}
+	}
+
+	if info, err := os.Lstat(filepath.Join(projectSkills, "go")); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Fatalf("go should be a symlink, info = %v, err = %v", info, err)
+	}
+	if info, err := os.Lstat(filepath.Join(projectSkills, "react")); err != nil || !info.IsDir() {
+		t.Fatalf("react should be a directory copy, info = %v, err = %v", info, err)
+	}
+	if _, err := os.Lstat(filepath.Join(projectSkills, "stale")); !os.IsNotExist(err) {
+		t.Fatalf("stale should be removed, err = %v", err)
+	}
+
+	buf.Reset()
+	cmd = newSyncCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("second Execute() error = %v", err)
+	}
+	got = buf.String()
+	for _, want := range []string{"[INFO] already linked go\n", "[INFO] already copied react\n"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("second output missing %q: %q", want, got)
+		}
+	}
+}
+
+func TestSyncCommandSwitchesLinkToCopy(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	projectSkills := filepath.Join(projectRoot, ".agents", "skills")
+	xdgConfig := filepath.Join(tmp, "xdg")
+	storeSkill := filepath.Join(xdgConfig, "bond", "go")
+
+	if err := os.MkdirAll(storeSkill, 0o755); err != nil {
+		t.Fatalf("MkdirAll(storeSkill) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	if err := os.MkdirAll(projectSkills, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectSkills) error = %v", err)
+	}
+	if err := os.Symlink(storeSkill, filepath.Join(projectSkills, "go")); err != nil {
+		t.Fatalf("Symlink(go) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte("skills:\n  - name: go\n    mode: copy\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", xdgConfig)
+
+	buf := &bytes.Buffer{}
+	cmd := newSyncCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "[OK] copied go (replaced link)\n") {
+		t.Fatalf("output missing replaced link line: %q", got)
+	}
+	if info, err := os.Lstat(filepath.Join(projectSkills, "go")); err != nil || !info.IsDir() {
+		t.Fatalf("go should be a directory copy, info = %v, err = %v", info, err)
+	}
+}
+
+func TestSyncCommandReportsMissingStoreSkill(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	xdgConfig := filepath.Join(tmp, "xdg")
+
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte("skills:\n  - name: missing\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", xdgConfig)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := newSyncCmd()
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	if !IsAlreadyReportedFailure(err) {
+		t.Fatalf("Execute() error = %v, want already-reported failure", err)
+	}
+	if got := stderr.String(); !strings.Contains(got, "[ERROR] missing: skill not found in store directory") {
+		t.Fatalf("stderr = %q, want missing skill error", got)
+	}
+}
+
+func TestSyncCommandRequiresManifest(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+
+	cmd := newSyncCmd()
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatal("Execute() error = nil, want missing manifest error")
+	}
+	if !strings.Contains(err.Error(), "no project manifest found") {
+		t.Fatalf("Execute() error = %q, want missing manifest message", err)
+	}
+}
+
+func TestSyncCommandRendersAdapterOutputs(t *testing.T) {
+	tmp := t.TempDir()
+	projectRoot := filepath.Join(tmp, "project")
+	xdgConfig := filepath.Join(tmp, "xdg")
+	skillDir := filepath.Join(xdgConfig, "bond", "go")
+
+	if err := os.MkdirAll(skillDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll(skillDir) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(skillDir, "SKILL.md"), []byte("---\nname: go\ndescription: Go\n---\n\nUse gofmt.\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	manifest := "adapters:\n  - cursor\nskills:\n  - name: go\n"
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte(manifest), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+
+	chdirForTest(t, projectRoot)
+	t.Setenv("XDG_CONFIG_HOME", xdgConfig)
+
+	run := func(cmd *cobra.Command) string {
+		t.Helper()
+		buf := &bytes.Buffer{}
+		cmd.SetOut(buf)
+		cmd.SetErr(buf)
+		cmd.SetArgs([]string{})
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("Execute(%s) error = %v", cmd.Name(), err)
+		}
+		return buf.String()
+	}
+
+	if got := run(newSyncCmd()); !strings.Contains(got, "[OK] rendered go for cursor\n") {
+		t.Fatalf("sync output = %q, want rendered go", got)
+	}
+	rulePath := filepath.Join(projectRoot, ".cursor", "rules", "go.mdc")
+	if got := run(newStatusCmd()); !strings.Contains(got, "[OK] current go (cursor "+rulePath+")\n") {
+		t.Fatalf("status output = %q, want current go", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(skillDir, "SKILL.md"), []byte("---\nname: go\ndescription: Go\n---\n\nUse gofmt and vet.\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	if got := run(newStatusCmd()); !strings.Contains(got, "[WARN] outdated go (cursor "+rulePath+")\n") {
+		t.Fatalf("status output = %q, want outdated go", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte("skills:\n  - name: go\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+	if got := run(newSyncCmd()); !strings.Contains(got, "[OK] removed go for cursor (not in manifest)\n") {
+		t.Fatalf("sync output = %q, want removed go", got)
+	}
+	if _, err := os.Stat(rulePath); !os.IsNotExist(err) {
+		t.Fatalf("go.mdc should be removed, err = %v", err)
+	}
+}
+
+func TestSyncCommandReportsUnrelatedDirectoryAsConflictInCopyMode(t *testing.T) {
+	_, projectRoot := setupGoSkillProject(t)
+	dest := filepath.Join(projectRoot, ".agents", "skills", "go")
+	mustMkdirAll(t, dest)
+	if err := os.WriteFile(filepath.Join(dest, "SKILL.md"), []byte("hand-written"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte("skills:\n  - name: go\n    mode: copy\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "sync")
+	if err != nil {
+		t.Fatalf("Execute(sync) error = %v", err)
+	}
+	if !strings.Contains(output, "[WARN] conflict go\n") {
+		t.Fatalf("output = %q, want conflict for the unrelated directory", output)
+	}
+
+	// A directory identical to the store skill counts as copied.
+	if err := os.WriteFile(filepath.Join(dest, "SKILL.md"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	output, err = executeRootForTest(t, "sync")
+	if err != nil {
+		t.Fatalf("Execute(sync) error = %v", err)
+	}
+	if !strings.Contains(output, "[INFO] already copied go\n") {
+		t.Fatalf("output = %q, want already copied", output)
+	}
+}
+
+func TestSyncCommandTracksCopiesOnlyInFirstTarget(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	manifest := "targets:\n  - .agents/skills\n  - .claude/skills\nskills:\n  - name: go\n    mode: copy\n"
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte(manifest), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "sync")
+	if err != nil {
+		t.Fatalf("Execute(sync) error = %v", err)
+	}
+	if want := "[OK] copied go in .agents/skills\n[OK] copied go in .claude/skills\n"; !strings.HasPrefix(output, want) {
+		t.Fatalf("output = %q, want copies in both targets", output)
+	}
+	lock, err := skills.LoadLockfile(config.ProjectLockFrom(projectRoot))
+	if err != nil {
+		t.Fatalf("LoadLockfile() error = %v", err)
+	}
+	if entry := lock.Skills["go"]; len(lock.Skills) != 1 || entry.Source != storeSkill {
+		t.Fatalf("lock.Skills = %+v, want one entry for the first target", lock.Skills)
+	}
+
+	// Once the store moves on, the tracked copy is outdated and the mirror is left alone.
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("newer"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	output, err = executeRootForTest(t, "sync")
+	if err != nil {
+		t.Fatalf("Execute(sync) error = %v", err)
+	}
+	for _, want := range []string{
+		"[INFO] already copied go in .agents/skills\n",
+		"[WARN] skipped go (differs from the store; copies outside the first target are not tracked, so remove it to copy again) in .claude/skills\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("output = %q, missing %q", output, want)
+		}
+	}
+	output, err = executeRootForTest(t, "status")
+	if err != nil {
+		t.Fatalf("Execute(status) error = %v", err)
+	}
+	if !strings.Contains(output, "[WARN] outdated go\n") {
+		t.Fatalf("status output = %q, want outdated go in the first target", output)
+	}
+}
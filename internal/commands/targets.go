@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+)
+
+// projectTarget is one project directory that receives skills.
+type projectTarget struct {
+	// name is the slash-separated path relative to the project root.
+	name string
+	dir  string
+}
+
+// projectTargets returns the bond.yaml targets, or the configured skills
+// directory when the manifest is missing or lists none.
+func projectTargets() ([]projectTarget, error) {
+	root, err := config.ProjectRoot()
+	if err != nil {
+		return nil, err
+	}
+	return projectTargetsFrom(root)
+}
+
+// projectTargetsFrom returns the targets of the project at root.
+func projectTargetsFrom(root string) ([]projectTarget, error) {
+	manifest, err := skills.LoadManifest(config.ProjectManifestFrom(root), "")
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	names := manifest.Targets
+	if len(names) == 0 {
+		name, err := config.ProjectSkillsDirName()
+		if err != nil {
+			return nil, err
+		}
+		names = []string{name}
+	}
+
+	targets := make([]projectTarget, 0, len(names))
+	for _, name := range names {
+		targets = append(targets, projectTarget{name: name, dir: filepath.Join(root, filepath.FromSlash(name))})
+	}
+	return targets, nil
+}
+
+// primarySkillsDir returns the first target. Copies are tracked, updated and
+// stored back from there.
+func primarySkillsDir() (string, error) {
+	targets, err := projectTargets()
+	if err != nil {
+		return "", err
+	}
+	return targets[0].dir, nil
+}
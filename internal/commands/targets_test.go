@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProjectTargetsDefaultsToSkillsDir(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+	t.Setenv("BOND_SKILLS_DIR", "")
+	chdirForTest(t, tmp)
+
+	targets, err := projectTargets()
+	if err != nil {
+		t.Fatalf("projectTargets() error = %v", err)
+	}
+	if len(targets) != 1 || targets[0].name != ".agents/skills" || targets[0].dir != filepath.Join(tmp, ".agents", "skills") {
+		t.Fatalf("targets = %+v, want default .agents/skills", targets)
+	}
+}
+
+func TestLinkUnlinkAndStatusCoverEveryTarget(t *testing.T) {
+	tmp := t.TempDir()
+	xdg := filepath.Join(tmp, "xdg")
+	storeSkill := filepath.Join(xdg, "bond", "go")
+	projectRoot := filepath.Join(tmp, "project")
+	if err := os.MkdirAll(storeSkill, 0o755); err != nil {
+		t.Fatalf("MkdirAll(storeSkill) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile(SKILL.md) error = %v", err)
+	}
+	if err := os.MkdirAll(projectRoot, 0o755); err != nil {
+		t.Fatalf("MkdirAll(projectRoot) error = %v", err)
+	}
+	manifest := "targets:\n  - .agents/skills\n  - .claude/skills\nskills: []\n"
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte(manifest), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	chdirForTest(t, projectRoot)
+
+	buf := &bytes.Buffer{}
+	cmd := newLinkCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"go"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute(link) error = %v", err)
+	}
+	if got, want := buf.String(), "[OK] linked go in .agents/skills\n[OK] linked go in .claude/skills\n"; got != want {
+		t.Fatalf("link output = %q, want %q", got, want)
+	}
+	for _, target := range []string{".agents", ".claude"} {
+		if _, err := os.Readlink(filepath.Join(projectRoot, target, "skills", "go")); err != nil {
+			t.Fatalf("Readlink(%s) error = %v", target, err)
+		}
+	}
+
+	buf.Reset()
+	cmd = newStatusCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute(status) error = %v", err)
+	}
+	output := buf.String()
+	for _, want := range []string{
+		"[INFO] project " + filepath.Join(projectRoot, ".agents", "skills") + "\n",
+		"[INFO] project " + filepath.Join(projectRoot, ".claude", "skills") + "\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("status output missing %q: %q", want, output)
+		}
+	}
+	if got := strings.Count(output, "[OK] linked go\n"); got != 2 {
+		t.Fatalf("status linked entries = %d, want 2 in %q", got, output)
+	}
+
+	buf.Reset()
+	cmd = newUnlinkCmd()
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"go"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute(unlink) error = %v", err)
+	}
+	if got, want := buf.String(), "[OK] unlinked go in .agents/skills\n[OK] unlinked go in .claude/skills\n"; got != want {
+		t.Fatalf("unlink output = %q, want %q", got, want)
+	}
+}
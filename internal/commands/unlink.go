@@ -1,82 +1,114 @@
 package commands
 
 import (
+	"fmt"
 	"path/filepath"
+	"strings"
 
-	"bond/internal/config"
 	"bond/internal/skills"
 	"github.com/spf13/cobra"
 )
 
 // newUnlinkCmd builds the command that removes project skill symlinks.
 func newUnlinkCmd() *cobra.Command {
+	var opts batchOptions
+
 	cmd := &cobra.Command{
-		Use:   "unlink [skill ...]",
-		Short: "Remove symlinked skills from ./.agents/skills",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  runUnlink,
+		Use:         "unlink [selector ...]",
+		Short:       "Remove symlinked skills from ./.agents/skills",
+		Long:        "Unlink removes project skill symlinks and leaves copies alone. " + selectorHelp + " " + atomicHelp,
+		Annotations: dryRunAnnotations(dryRunSupported),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUnlink(cmd, args, opts)
+		},
 	}
 
+	addBatchFlags(cmd, &opts)
 	cmd.ValidArgsFunction = completeLinkedSkills
 	return cmd
 }
 
 // runUnlink executes unlink operations and prints per-skill status.
-func runUnlink(cmd *cobra.Command, args []string) error {
-	skillsDir, err := config.ProjectSkillsDir()
+func runUnlink(cmd *cobra.Command, args []string, opts batchOptions) error {
+	targets, err := projectTargets()
 	if err != nil {
 		return err
 	}
 
-	entries, err := resolveUnlinkTargets(skillsDir, args)
+	names, err := resolveUnlinkNames(targets, args, opts.selectors)
 	if err != nil {
 		return err
 	}
+	selected := make([]skills.Skill, 0, len(names))
+	for _, name := range names {
+		selected = append(selected, skills.Skill{Name: name})
+	}
 
-	var hardErrs int
-	for _, entry := range entries {
-		removed, err := skills.Unlink(entry.Path)
+	action := func(skill skills.Skill, target projectTarget) (skillActionOutput, error) {
+		path := filepath.Join(target.dir, skill.Name)
+		removed, ops, err := unlinkSkill(path)
 		if err != nil {
-			hardErrs++
-			if printErrErr := printErr(cmd, levelError, "%s: %v", entry.Name, err); printErrErr != nil {
-				return printErrErr
-			}
-			continue
+			return skillActionOutput{}, err
 		}
-		if removed {
-			if err := printOut(cmd, levelOK, "unlinked %s", entry.Name); err != nil {
-				return err
-			}
-		} else {
-			if err := printOut(cmd, levelWarn, "skipped %s (not a symlink)", entry.Name); err != nil {
-				return err
+		if !removed {
+			return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (not a symlink)", skill.Name), status: "skipped", path: path}, nil
+		}
+		return skillActionOutput{level: levelOK, message: fmt.Sprintf("unlinked %s", skill.Name), status: "unlinked", path: path, ops: ops}, nil
+	}
+	if opts.atomic {
+		return runAtomicSkillActions(cmd, selected, targets, action)
+	}
+	return runSkillActions(cmd, selected, targets, action)
+}
+
+// resolveUnlinkNames resolves selectors against the links in every target.
+// Plain names that match no link are kept outside strict mode, so unlink
+// reports them as skipped instead of failing.
+func resolveUnlinkNames(targets []projectTarget, args []string, opts selectorOptions) ([]string, error) {
+	linked := []skills.Skill{}
+	for _, target := range targets {
+		entries, err := skills.DiscoverLinked(target.dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			linked = append(linked, skills.Skill{Name: entry.Name, Path: entry.Path, Meta: skills.ReadMetadata(entry.Path)})
+		}
+	}
+
+	candidates := linked
+	if !opts.strict {
+		for _, arg := range args {
+			if skills.IsPlainSelector(arg) && !strings.HasPrefix(arg, "!") && !strings.Contains(arg, ":") {
+				candidates = append(candidates, skills.Skill{Name: arg})
 			}
 		}
 	}
 
-	if hardErrs > 0 {
-		return alreadyReportedFailure()
+	selection, err := skills.SelectSkills(candidates, args, opts.all)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStrictSelection(linked, selection, opts); err != nil {
+		return nil, err
+	}
+	if len(selection.Skills) == 0 {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("no linked skills to unlink")
+		}
+		return nil, fmt.Errorf("no matching skills: %s", strings.Join(args, ", "))
 	}
-	return nil
-}
 
-// resolveUnlinkTargets builds unlink candidates from args or discovered links.
-func resolveUnlinkTargets(skillsDir string, args []string) ([]skills.Entry, error) {
-	entries := make([]skills.Entry, 0, len(args))
-	for _, name := range args {
-		// Explicit args are accepted even if the path does not exist yet; Unlink
-		// handles the missing path case and reports it as a no-op.
-		entries = append(entries, skills.Entry{
-			Name: name,
-			Path: filepath.Join(skillsDir, name),
-		})
+	names := make([]string, 0, len(selection.Skills))
+	for _, skill := range selection.Skills {
+		names = append(names, skill.Name)
 	}
-	return entries, nil
+	return names, nil
 }
 
 // completeLinkedSkills offers shell completions from currently linked skills.
 func completeLinkedSkills(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	skillsDir, err := config.ProjectSkillsDir()
+	skillsDir, err := primarySkillsDir()
 	if err != nil {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	}
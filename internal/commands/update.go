@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// newUpdateCmd builds the command that refreshes project copies from the store.
+func newUpdateCmd() *cobra.Command {
+	var force bool
+	var opts selectorOptions
+
+	cmd := &cobra.Command{
+		Use:         "update [selector ...]",
+		Short:       "Refresh copied skills in ./.agents/skills from the store",
+		Long:        "Update replaces project copies with the current store version. With no arguments, every copy recorded in bond.lock is updated. Copies with local modifications are skipped unless --force is set. " + selectorHelp,
+		Annotations: dryRunAnnotations(dryRunUnsupported),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUpdate(cmd, args, force, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite local modifications and copies without a bond.lock record")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Select every project copy, including copies without a bond.lock record")
+	cmd.Flags().BoolVar(&opts.strict, "strict", false, "Fail when a selector matches nothing")
+	cmd.ValidArgsFunction = completeCopiedSkills
+	return cmd
+}
+
+// runUpdate replaces selected project copies and prints per-skill status.
+func runUpdate(cmd *cobra.Command, args []string, force bool, opts selectorOptions) error {
+	stores, err := configuredStores()
+	if err != nil {
+		return err
+	}
+
+	skillsDir, err := primarySkillsDir()
+	if err != nil {
+		return err
+	}
+
+	lockPath, err := config.ProjectLockFile()
+	if err != nil {
+		return err
+	}
+	lock, err := skills.LoadLockfile(lockPath)
+	if err != nil {
+		return err
+	}
+	baseDir, err := config.ProjectBaseDir()
+	if err != nil {
+		return err
+	}
+
+	storeByName, err := visibleStoreSkills(stores)
+	if err != nil {
+		return err
+	}
+
+	copies, err := skills.DiscoverProjectStorable(skillsDir)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 && !opts.all {
+		args = lockedSkillNames(lock)
+		if len(args) == 0 {
+			return printOut(cmd, levelInfo, "no copied skills to update")
+		}
+	}
+
+	recorded := false
+	runErr := runDiscoveredSkillActions(cmd, copies, args, opts, func(skill skills.Skill) (skillActionOutput, error) {
+		storeSkill, ok, err := copySource(storeByName, lock, skill.Name)
+		if err != nil {
+			return skillActionOutput{}, err
+		}
+		if !ok {
+			return skillActionOutput{}, errCopySourceMissing(stores, lock, skill.Name)
+		}
+
+		entry, locked := lock.Skills[skill.Name]
+		if !locked && !force {
+			return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (no copy record; use --force)", skill.Name), status: "skipped", path: skill.Path}, nil
+		}
+
+		projectDigest, err := skills.DigestDir(skill.Path)
+		if err != nil {
+			return skillActionOutput{}, err
+		}
+		storeDigest, err := skills.DigestDir(storeSkill.Path)
+		if err != nil {
+			return skillActionOutput{}, err
+		}
+
+		if projectDigest == storeDigest {
+			if !locked || entry.Digest != storeDigest {
+				if err := recordCopy(&lock, baseDir, skill.Name, storeSkill.Path, skill.Path); err != nil {
+					return skillActionOutput{}, err
+				}
+				recorded = true
+			}
+			return skillActionOutput{level: levelInfo, message: fmt.Sprintf("already up to date %s", skill.Name), status: "up_to_date", path: skill.Path}, nil
+		}
+		if locked && projectDigest != entry.Digest && !force {
+			return skillActionOutput{level: levelWarn, message: fmt.Sprintf("skipped %s (local modifications; use --force)", skill.Name), status: "skipped", path: skill.Path}, nil
+		}
+
+		if err := skills.Replace(storeSkill.Path, skill.Path, ""); err != nil {
+			return skillActionOutput{}, err
+		}
+		if err := recordCopy(&lock, baseDir, skill.Name, storeSkill.Path, skill.Path); err != nil {
+			return skillActionOutput{}, err
+		}
+		recorded = true
+		return skillActionOutput{level: levelOK, message: fmt.Sprintf("updated %s", skill.Name), status: "updated", path: skill.Path}, nil
+	})
+
+	if recorded {
+		if err := skills.SaveLockfile(lockPath, lock); err != nil {
+			return err
+		}
+	}
+	if err := registerProject(); err != nil {
+		return err
+	}
+	return runErr
+}
+
+// lockedSkillNames returns the skill names recorded in lock in sorted order.
+func lockedSkillNames(lock skills.Lockfile) []string {
+	names := make([]string, 0, len(lock.Skills))
+	for name := range lock.Skills {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// completeCopiedSkills offers shell completions from project copies.
+func completeCopiedSkills(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	skillsDir, err := primarySkillsDir()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	copies, err := skills.DiscoverProjectStorable(skillsDir)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	candidates := make([]string, 0, len(copies))
+	for _, skill := range copies {
+		candidates = append(candidates, skill.Name)
+	}
+
+	return candidates, cobra.ShellCompDirectiveNoFileComp
+}
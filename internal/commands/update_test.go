@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+)
+
+func TestUpdateCommandRefreshesCleanCopy(t *testing.T) {
+	_, storeSkill, projectSkill := setupCopiedSkillForUpdate(t)
+
+	got, err := executeUpdateForTest(t)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(got, "[INFO] already up to date go\n") {
+		t.Fatalf("output missing up to date line: %q", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("v2"), 0o644); err != nil {
+		t.Fatalf("WriteFile(store SKILL.md) error = %v", err)
+	}
+
+	got, err = executeUpdateForTest(t, "go")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(got, "[OK] updated go\n") {
+		t.Fatalf("output missing updated line: %q", got)
+	}
+
+	contents, err := os.ReadFile(filepath.Join(projectSkill, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile(project SKILL.md) error = %v", err)
+	}
+	if string(contents) != "v2" {
+		t.Fatalf("project SKILL.md = %q, want v2", string(contents))
+	}
+}
+
+func TestUpdateCommandRefusesLocalModificationsWithoutForce(t *testing.T) {
+	_, storeSkill, projectSkill := setupCopiedSkillForUpdate(t)
+
+	if err := os.WriteFile(filepath.Join(storeSkill, "SKILL.md"), []byte("v2"), 0o644); err != nil {
+		t.Fatalf("WriteFile(store SKILL.md) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectSkill, "SKILL.md"), []byte("local"), 0o644); err != nil {
+		t.Fatalf("WriteFile(project SKILL.md) error = %v", err)
+	}
+
+	got, err := executeUpdateForTest(t, "go")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(got, "[WARN] skipped go (local modifications; use --force)\n") {
+		t.Fatalf("output missing local modifications warning: %q", got)
+	}
+
+	got, err = executeUpdateForTest(t, "--force", "go")
+	if err != nil {
+		t.Fatalf("Execute(--force) error = %v", err)
+	}
+	if !strings.Contains(got, "[OK] updated go\n") {
+		t.Fatalf("output missing updated line: %q", got)
+	}
+
+	contents, err := os.ReadFile(filepath.Join(projectSkill, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile(project SKILL.md) error = %v", err)
+	}
+	if string(contents) != "v2" {
+		t.Fatalf("project SKILL.md = %q, want v2", string(contents))
+	}
+}
+
+func TestUpdateCommandSkipsCopiesWithoutRecord(t *testing.T) {
+	projectRoot, _, _ := setupCopiedSkillForUpdate(t)
+
+	if err := os.Remove(filepath.Join(projectRoot, "bond.lock")); err != nil {
+		t.Fatalf("Remove(bond.lock) error = %v", err)
+	}
+
+	got, err := executeUpdateForTest(t, "go")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(got, "[WARN] skipped go (no copy record; use --force)\n") {
+		t.Fatalf("output missing no record warning: %q", got)
+	}
+}
+
+func TestUpdateCommandFollowsRecordedSourceOfShadowedCopy(t *testing.T) {
+	_, projectRoot := setupGoSkillProject(t)
+	tmp := filepath.Dir(projectRoot)
+	personal := filepath.Join(tmp, "personal")
+	team := filepath.Join(tmp, "team")
+	for _, store := range []string{personal, team} {
+		mustMkdirAll(t, filepath.Join(store, "go"))
+		if err := os.WriteFile(filepath.Join(store, "go", "SKILL.md"), []byte("go from "+filepath.Base(store)), 0o644); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", store, err)
+		}
+	}
+	t.Setenv("BOND_STORES", "personal="+personal+string(os.PathListSeparator)+"team="+team)
+
+	if _, err := executeRootForTest(t, "copy", "team:go"); err != nil {
+		t.Fatalf("Execute(copy team:go) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(team, "go", "SKILL.md"), []byte("newer team go"), 0o644); err != nil {
+		t.Fatalf("WriteFile(team go) error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "update")
+	if err != nil {
+		t.Fatalf("Execute(update) error = %v", err)
+	}
+	if output != "[OK] updated go\n" {
+		t.Fatalf("output = %q, want updated go", output)
+	}
+	raw, err := os.ReadFile(filepath.Join(projectRoot, ".agents", "skills", "go", "SKILL.md"))
+	if err != nil || string(raw) != "newer team go" {
+		t.Fatalf("project SKILL.md = %q, %v, want the team version", raw, err)
+	}
+	lock, err := skills.LoadLockfile(config.ProjectLockFrom(projectRoot))
+	if err != nil {
+		t.Fatalf("LoadLockfile() error = %v", err)
+	}
+	if got := lock.Skills["go"].Source; got != filepath.Join(team, "go") {
+		t.Fatalf("lock source = %q, want team go", got)
+	}
+}
@@ -60,6 +60,19 @@ func runValidate(cmd *cobra.Command, args []string, all bool) error {
 
 	var invalidSkills int
 	for _, result := range results {
+		if structuredOutput() {
+			if len(result.Issues) > 0 {
+				invalidSkills++
+			}
+			if result.Issues == nil {
+				result.Issues = []skills.ValidationIssue{}
+			}
+			if err := writeRecord(cmd.OutOrStdout(), result); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if len(result.Issues) == 0 {
 			if err := printOut(cmd, levelOK, "%s", result.Name); err != nil {
 				return err
@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"path/filepath"
+	"time"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+	"github.com/spf13/cobra"
+)
+
+// registerProject adds the current project to the project registry so bond
+// where can find it later. Commands that link or copy skills call it. Dry runs leave the registry alone.
+func registerProject() error {
+	if dryRun {
+		return nil
+	}
+	root, err := config.ProjectRoot()
+	if err != nil {
+		return err
+	}
+	path, err := config.ProjectRegistryFile()
+	if err != nil {
+		return err
+	}
+	registry, err := skills.LoadProjectRegistry(path)
+	if err != nil {
+		return err
+	}
+	registry.Register(root, time.Now())
+	return skills.SaveProjectRegistry(path, registry)
+}
+
+// whereRecord is the structured form of one project using a skill.
+type whereRecord struct {
+	Project string            `json:"project"`
+	Target  string            `json:"target"`
+	Path    string            `json:"path"`
+	Mode    skills.UsageMode  `json:"mode"`
+	Status  skills.StatusKind `json:"status"`
+	Level   string            `json:"level"`
+}
+
+// projectRecord is the structured form of a registered project that was
+// dropped from the registry or skipped.
+type projectRecord struct {
+	Project string `json:"project"`
+	Status  string `json:"status"`
+	Level   string `json:"level"`
+	Error   string `json:"error,omitempty"`
+	DryRun  bool   `json:"dry_run,omitempty"`
+}
+
+// newWhereCmd builds the command that lists the projects using a store skill.
+func newWhereCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "where <skill>",
+		Short:       "List the known projects that link or copy a store skill",
+		Long:        "Where looks through every project bond has linked or copied skills into, or initialized, and lists those that use the store skill, whether by link or by copy, and whether the copy was modified. Projects whose directories no longer exist are forgotten.",
+		Args:        cobra.ExactArgs(1),
+		Annotations: dryRunAnnotations(dryRunSupported),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWhere(cmd, args[0])
+		},
+	}
+
+	cmd.ValidArgsFunction = completeStoreSkills
+	return cmd
+}
+
+// runWhere prints every registered project target using the skill selected by name.
+func runWhere(cmd *cobra.Command, name string) error {
+	stores, err := configuredStores()
+	if err != nil {
+		return err
+	}
+	skill, err := resolveStoreSkill(stores, name)
+	if err != nil {
+		return err
+	}
+
+	registry, err := knownProjects(cmd)
+	if err != nil {
+		return err
+	}
+
+	usages, err := findProjectUsages(cmd, stores, registry, skill)
+	if err != nil {
+		return err
+	}
+	if len(usages) == 0 && !structuredOutput() {
+		return printOut(cmd, levelInfo, "no known project uses %s", skill.Name)
+	}
+	for _, usage := range usages {
+		if err := printResult(cmd, usage.Level, usage, "%s %s (%s %s)", usage.Project, usage.Target, usage.Mode, usage.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// knownProjects loads the project registry, dropping and reporting projects
+// whose directories no longer exist.
+func knownProjects(cmd *cobra.Command) (skills.ProjectRegistry, error) {
+	path, err := config.ProjectRegistryFile()
+	if err != nil {
+		return skills.ProjectRegistry{}, err
+	}
+	registry, err := skills.LoadProjectRegistry(path)
+	if err != nil {
+		return skills.ProjectRegistry{}, err
+	}
+	pruned, err := registry.Prune()
+	if err != nil {
+		return skills.ProjectRegistry{}, err
+	}
+	for _, project := range pruned {
+		record := projectRecord{Project: project.Path, Status: "pruned", Level: levelInfo, DryRun: dryRun}
+		if err := printResult(cmd, levelInfo, record, "forgot %s (no longer exists)", project.Path); err != nil {
+			return skills.ProjectRegistry{}, err
+		}
+	}
+	if len(pruned) > 0 && !dryRun {
+		if err := skills.SaveProjectRegistry(path, registry); err != nil {
+			return skills.ProjectRegistry{}, err
+		}
+	}
+	return registry, nil
+}
+
+// findProjectUsages looks for skill in every target of every registered
+// project. A project that cannot be inspected, for example because its
+// bond.yaml or bond.lock is invalid, is reported and skipped.
+func findProjectUsages(cmd *cobra.Command, stores []skills.StoreDir, registry skills.ProjectRegistry, skill skills.Skill) ([]whereRecord, error) {
+	records := []whereRecord{}
+	for _, project := range registry.Projects {
+		usages, err := projectUsages(stores, project.Path, skill)
+		if err != nil {
+			record := projectRecord{Project: project.Path, Status: "skipped", Level: levelWarn, Error: err.Error()}
+			if err := printResult(cmd, levelWarn, record, "skipped %s: %v", project.Path, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		records = append(records, usages...)
+	}
+	return records, nil
+}
+
+// projectUsages looks for skill in every target of the project at root.
+func projectUsages(stores []skills.StoreDir, root string, skill skills.Skill) ([]whereRecord, error) {
+	targets, err := projectTargetsFrom(root)
+	if err != nil {
+		return nil, err
+	}
+	lock, err := skills.LoadLockfile(config.ProjectLockFrom(root))
+	if err != nil {
+		return nil, err
+	}
+
+	records := []whereRecord{}
+	for _, target := range targets {
+		usages, err := skills.FindSkillUsage(stores, target.dir, lock, skill)
+		if err != nil {
+			return nil, err
+		}
+		for _, usage := range usages {
+			records = append(records, whereRecord{
+				Project: root,
+				Target:  filepath.ToSlash(target.name),
+				Path:    usage.Path,
+				Mode:    usage.Mode,
+				Status:  usage.Status,
+				Level:   statusLevel(usage.Status),
+			})
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bond/internal/config"
+	"bond/internal/skills"
+)
+
+func TestWhereListsRegisteredProjectsAndPrunesMissingOnes(t *testing.T) {
+	storeSkill, projectRoot := setupGoSkillProject(t)
+	other := filepath.Join(filepath.Dir(projectRoot), "other")
+	gone := filepath.Join(filepath.Dir(projectRoot), "gone")
+	mustMkdirAll(t, filepath.Join(other, ".agents", "skills"))
+	mustMkdirAll(t, gone)
+
+	if _, err := executeRootForTest(t, "link", "go"); err != nil {
+		t.Fatalf("Execute(link) error = %v", err)
+	}
+	if _, err := executeRootForTest(t, "--project", other, "copy", "go"); err != nil {
+		t.Fatalf("Execute(copy) error = %v", err)
+	}
+	if _, err := executeRootForTest(t, "--project", gone, "init"); err != nil {
+		t.Fatalf("Execute(init) error = %v", err)
+	}
+	if err := os.RemoveAll(gone); err != nil {
+		t.Fatalf("RemoveAll(gone) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(other, ".agents", "skills", "go", "SKILL.md"), []byte("edited"), 0o644); err != nil {
+		t.Fatalf("WriteFile(copy) error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "where", "go")
+	if err != nil {
+		t.Fatalf("Execute(where) error = %v", err)
+	}
+	for _, want := range []string{
+		"[INFO] forgot " + gone + " (no longer exists)\n",
+		"[WARN] " + other + " .agents/skills (copy modified)\n",
+		"[OK] " + projectRoot + " .agents/skills (link linked)\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("output = %q, missing %q", output, want)
+		}
+	}
+
+	registryPath, err := config.ProjectRegistryFile()
+	if err != nil {
+		t.Fatalf("ProjectRegistryFile() error = %v", err)
+	}
+	registry, err := skills.LoadProjectRegistry(registryPath)
+	if err != nil {
+		t.Fatalf("LoadProjectRegistry() error = %v", err)
+	}
+	if len(registry.Projects) != 2 {
+		t.Fatalf("projects = %+v, want gone pruned", registry.Projects)
+	}
+	if filepath.Dir(registryPath) != filepath.Join(filepath.Dir(storeSkill), ".bond") {
+		t.Fatalf("registry path = %q, want store state directory", registryPath)
+	}
+}
+
+func TestWhereReportsUnusedSkill(t *testing.T) {
+	setupGoSkillProject(t)
+
+	output, err := executeRootForTest(t, "where", "go")
+	if err != nil {
+		t.Fatalf("Execute(where) error = %v", err)
+	}
+	if want := "[INFO] no known project uses go\n"; output != want {
+		t.Fatalf("output = %q, want %q", output, want)
+	}
+}
+
+func TestWhereFindsProjectsSetUpOnlyWithSync(t *testing.T) {
+	_, projectRoot := setupGoSkillProject(t)
+	if err := os.WriteFile(filepath.Join(projectRoot, "bond.yaml"), []byte("skills:\n  - name: go\n    mode: link\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+
+	if _, err := executeRootForTest(t, "sync"); err != nil {
+		t.Fatalf("Execute(sync) error = %v", err)
+	}
+	output, err := executeRootForTest(t, "where", "go")
+	if err != nil {
+		t.Fatalf("Execute(where) error = %v", err)
+	}
+	if want := "[OK] " + projectRoot + " .agents/skills (link linked)\n"; output != want {
+		t.Fatalf("output = %q, want %q", output, want)
+	}
+}
+
+func TestWhereSkipsProjectsThatCannotBeInspected(t *testing.T) {
+	_, projectRoot := setupGoSkillProject(t)
+	broken := filepath.Join(filepath.Dir(projectRoot), "broken")
+	mustMkdirAll(t, filepath.Join(broken, ".agents", "skills"))
+
+	if _, err := executeRootForTest(t, "link", "go"); err != nil {
+		t.Fatalf("Execute(link) error = %v", err)
+	}
+	if _, err := executeRootForTest(t, "--project", broken, "init"); err != nil {
+		t.Fatalf("Execute(init) error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(broken, "bond.lock"), []byte("skills: [\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.lock) error = %v", err)
+	}
+
+	output, err := executeRootForTest(t, "where", "go")
+	if err != nil {
+		t.Fatalf("Execute(where) error = %v", err)
+	}
+	if !strings.Contains(output, "[WARN] skipped "+broken+": invalid lockfile") {
+		t.Fatalf("output = %q, want broken project skipped", output)
+	}
+	if !strings.Contains(output, "[OK] "+projectRoot+" .agents/skills (link linked)\n") {
+		t.Fatalf("output = %q, want linked project listed", output)
+	}
+}
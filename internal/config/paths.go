@@ -5,9 +5,59 @@ import (
 	"path/filepath"
 )
 
-// ProjectRoot returns the current working directory for command execution.
+// projectRootOverride holds the --project directory when one was given.
+var projectRootOverride string
+
+// SetProjectRootOverride makes ProjectRoot return dir instead of searching from
+// the working directory. An empty dir restores the search.
+func SetProjectRootOverride(dir string) {
+	projectRootOverride = dir
+}
+
+// ProjectRoot returns the --project override, or the nearest project root at
+// or above the working directory.
 func ProjectRoot() (string, error) {
-	return os.Getwd()
+	if projectRootOverride != "" {
+		return filepath.Abs(projectRootOverride)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	return FindProjectRoot(wd)
+}
+
+// FindProjectRoot walks up from start to the nearest directory holding a
+// .agents directory, a bond.yaml manifest, or a .git entry. It returns start
+// when no ancestor qualifies.
+func FindProjectRoot(start string) (string, error) {
+	startAbs, err := filepath.Abs(start)
+	if err != nil {
+		return "", err
+	}
+
+	for dir := startAbs; ; dir = filepath.Dir(dir) {
+		if isProjectRoot(dir) {
+			return dir, nil
+		}
+		if filepath.Dir(dir) == dir {
+			return startAbs, nil
+		}
+	}
+}
+
+// isProjectRoot reports whether dir holds one of the project root markers.
+func isProjectRoot(dir string) bool {
+	if info, err := os.Stat(ProjectAgentsDirFrom(dir)); err == nil && info.IsDir() {
+		return true
+	}
+	if info, err := os.Stat(ProjectManifestFrom(dir)); err == nil && !info.IsDir() {
+		return true
+	}
+	// .git is a file in worktrees and submodules.
+	_, err := os.Lstat(filepath.Join(dir, ".git"))
+	return err == nil
 }
 
 // ProjectAgentsDir returns the project-local .agents directory path.
@@ -19,13 +69,27 @@ func ProjectAgentsDir() (string, error) {
 	return ProjectAgentsDirFrom(root), nil
 }
 
-// ProjectSkillsDir returns the project-local .agents/skills directory path.
+// ProjectSkillsDir returns the project skills directory, .agents/skills unless
+// skills_dir is configured.
 func ProjectSkillsDir() (string, error) {
 	root, err := ProjectRoot()
 	if err != nil {
 		return "", err
 	}
-	return ProjectSkillsDirFrom(root), nil
+	name, err := ProjectSkillsDirName()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, filepath.FromSlash(name)), nil
+}
+
+// ProjectSkillsDirName returns the configured skills directory relative to the project root.
+func ProjectSkillsDirName() (string, error) {
+	resolved, err := ResolveSetting(KeySkillsDir)
+	if err != nil {
+		return "", err
+	}
+	return resolved.Value, nil
 }
 
 // ProjectAgentsDirFrom builds the .agents path from an explicit project root.
@@ -38,8 +102,124 @@ func ProjectSkillsDirFrom(root string) string {
 	return filepath.Join(ProjectAgentsDirFrom(root), "skills")
 }
 
-// StoreSkillsDir returns the store Bond skills directory based on XDG conventions.
+// ProjectBaseDir returns the project directory holding pristine copies of copied skills.
+func ProjectBaseDir() (string, error) {
+	root, err := ProjectRoot()
+	if err != nil {
+		return "", err
+	}
+	return ProjectBaseDirFrom(root), nil
+}
+
+// ProjectStateDirFrom builds the .agents/.bond state path from an explicit project root.
+func ProjectStateDirFrom(root string) string {
+	return filepath.Join(ProjectAgentsDirFrom(root), ".bond")
+}
+
+// ProjectBaseDirFrom builds the .agents/.bond/base path from an explicit project root.
+func ProjectBaseDirFrom(root string) string {
+	return filepath.Join(ProjectStateDirFrom(root), "base")
+}
+
+// ProjectTransactionFile returns the journal of the atomic batch being applied.
+func ProjectTransactionFile() (string, error) {
+	root, err := ProjectRoot()
+	if err != nil {
+		return "", err
+	}
+	return ProjectTransactionFrom(root), nil
+}
+
+// ProjectTransactionFrom builds the .agents/.bond/transaction.yaml path from an explicit project root.
+func ProjectTransactionFrom(root string) string {
+	return filepath.Join(ProjectStateDirFrom(root), "transaction.yaml")
+}
+
+// ProjectHistoryFile returns the record of operations bond applied in the project.
+func ProjectHistoryFile() (string, error) {
+	root, err := ProjectRoot()
+	if err != nil {
+		return "", err
+	}
+	return ProjectHistoryFrom(root), nil
+}
+
+// ProjectHistoryFrom builds the .agents/.bond/history.yaml path from an explicit project root.
+func ProjectHistoryFrom(root string) string {
+	return filepath.Join(ProjectStateDirFrom(root), "history.yaml")
+}
+
+// ProjectManifestFile returns the project bond.yaml manifest path.
+func ProjectManifestFile() (string, error) {
+	root, err := ProjectRoot()
+	if err != nil {
+		return "", err
+	}
+	return ProjectManifestFrom(root), nil
+}
+
+// ProjectManifestFrom builds the bond.yaml manifest path from an explicit project root.
+func ProjectManifestFrom(root string) string {
+	return filepath.Join(root, "bond.yaml")
+}
+
+// ProjectLockFile returns the project bond.lock path.
+func ProjectLockFile() (string, error) {
+	root, err := ProjectRoot()
+	if err != nil {
+		return "", err
+	}
+	return ProjectLockFrom(root), nil
+}
+
+// ProjectLockFrom builds the bond.lock path from an explicit project root.
+func ProjectLockFrom(root string) string {
+	return filepath.Join(root, "bond.lock")
+}
+
+// StoreStateDirFrom builds the hidden .bond state path inside a store directory.
+// Discovery skips hidden directories, so state kept here never shows up as skills.
+func StoreStateDirFrom(storeDir string) string {
+	return filepath.Join(storeDir, ".bond")
+}
+
+// StoreBackupDirFrom builds the store backup path for replaced skills.
+func StoreBackupDirFrom(storeDir string) string {
+	return filepath.Join(StoreStateDirFrom(storeDir), "backups")
+}
+
+// StoreTrashDirFrom builds the path where removed skills are kept.
+func StoreTrashDirFrom(storeDir string) string {
+	return filepath.Join(StoreStateDirFrom(storeDir), "trash")
+}
+
+// StoreTrashFileFrom builds the index of removed skills for a store.
+func StoreTrashFileFrom(storeDir string) string {
+	return filepath.Join(StoreStateDirFrom(storeDir), "trash.yaml")
+}
+
+// ProjectRegistryFile returns the list of projects bond linked or copied
+// skills into. It is kept in the default store's state directory.
+func ProjectRegistryFile() (string, error) {
+	dir, err := DefaultStoreDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(StoreStateDirFrom(dir), "projects.yaml"), nil
+}
+
+// StoreSkillsDir returns the primary store directory, the first entry of Stores.
+// New skills are created and stored there.
 func StoreSkillsDir() (string, error) {
+	stores, err := Stores()
+	if err != nil {
+		return "", err
+	}
+	return stores[0].Path, nil
+}
+
+// DefaultStoreDir returns the store Bond skills directory based on XDG conventions.
+func DefaultStoreDir() (string, error) {
 	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
 		return filepath.Join(xdg, "bond"), nil
 	}
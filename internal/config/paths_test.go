@@ -15,6 +15,12 @@ func TestProjectDirsFrom(t *testing.T) {
 	if got := ProjectSkillsDirFrom(root); got != filepath.Join(root, ".agents", "skills") {
 		t.Fatalf("ProjectSkillsDirFrom() = %q", got)
 	}
+	if got := ProjectStateDirFrom(root); got != filepath.Join(root, ".agents", ".bond") {
+		t.Fatalf("ProjectStateDirFrom() = %q", got)
+	}
+	if got := ProjectBaseDirFrom(root); got != filepath.Join(root, ".agents", ".bond", "base") {
+		t.Fatalf("ProjectBaseDirFrom() = %q", got)
+	}
 }
 
 // TestStoreSkillsDirPrefersXDG ensures XDG_CONFIG_HOME takes precedence.
@@ -47,3 +53,81 @@ func TestStoreSkillsDirFallsBackToHome(t *testing.T) {
 		t.Fatalf("StoreSkillsDir() = %q, want %q", got, want)
 	}
 }
+
+// TestProjectManifestAndLockFrom verifies manifest files live at the project root.
+func TestProjectManifestAndLockFrom(t *testing.T) {
+	root := "/tmp/work"
+	if got := ProjectManifestFrom(root); got != filepath.Join(root, "bond.yaml") {
+		t.Fatalf("ProjectManifestFrom() = %q", got)
+	}
+	if got := ProjectLockFrom(root); got != filepath.Join(root, "bond.lock") {
+		t.Fatalf("ProjectLockFrom() = %q", got)
+	}
+}
+
+// TestStoreStateDirsFrom verifies store state lives in a hidden directory.
+func TestStoreStateDirsFrom(t *testing.T) {
+	store := "/tmp/store"
+	if got := StoreStateDirFrom(store); got != filepath.Join(store, ".bond") {
+		t.Fatalf("StoreStateDirFrom() = %q", got)
+	}
+	if got := StoreBackupDirFrom(store); got != filepath.Join(store, ".bond", "backups") {
+		t.Fatalf("StoreBackupDirFrom() = %q", got)
+	}
+}
+
+// TestFindProjectRootWalksUpToMarkers ensures the nearest marked ancestor wins.
+func TestFindProjectRootWalksUpToMarkers(t *testing.T) {
+	tmp := t.TempDir()
+	repo := filepath.Join(tmp, "repo")
+	nested := filepath.Join(repo, "pkg", "sub")
+	if err := os.MkdirAll(nested, 0o755); err != nil {
+		t.Fatalf("MkdirAll(nested) error = %v", err)
+	}
+
+	got, err := FindProjectRoot(nested)
+	if err != nil {
+		t.Fatalf("FindProjectRoot() error = %v", err)
+	}
+	if got != nested {
+		t.Fatalf("FindProjectRoot() without markers = %q, want start %q", got, nested)
+	}
+
+	if err := os.MkdirAll(filepath.Join(repo, ".git"), 0o755); err != nil {
+		t.Fatalf("MkdirAll(.git) error = %v", err)
+	}
+	got, err = FindProjectRoot(nested)
+	if err != nil {
+		t.Fatalf("FindProjectRoot() error = %v", err)
+	}
+	if got != repo {
+		t.Fatalf("FindProjectRoot() = %q, want git root %q", got, repo)
+	}
+
+	pkg := filepath.Join(repo, "pkg")
+	if err := os.WriteFile(filepath.Join(pkg, "bond.yaml"), []byte("skills: []\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile(bond.yaml) error = %v", err)
+	}
+	got, err = FindProjectRoot(nested)
+	if err != nil {
+		t.Fatalf("FindProjectRoot() error = %v", err)
+	}
+	if got != pkg {
+		t.Fatalf("FindProjectRoot() = %q, want nearest manifest dir %q", got, pkg)
+	}
+}
+
+// TestProjectRootOverride ensures --project bypasses the upward search.
+func TestProjectRootOverride(t *testing.T) {
+	dir := t.TempDir()
+	SetProjectRootOverride(dir)
+	t.Cleanup(func() { SetProjectRootOverride("") })
+
+	got, err := ProjectRoot()
+	if err != nil {
+		t.Fatalf("ProjectRoot() error = %v", err)
+	}
+	if got != dir {
+		t.Fatalf("ProjectRoot() = %q, want %q", got, dir)
+	}
+}
@@ -0,0 +1,344 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Setting keys accepted in config.yaml and by bond config get/set.
+const (
+	KeyStores    = "stores"
+	KeySyncMode  = "sync_mode"
+	KeySkillsDir = "skills_dir"
+	KeyColor     = "color"
+	KeyNoLevel   = "no_level"
+	KeyEditor    = "editor"
+)
+
+// Environment variables that override config.yaml settings.
+const (
+	EnvSyncMode  = "BOND_SYNC_MODE"
+	EnvSkillsDir = "BOND_SKILLS_DIR"
+	EnvEditor    = "BOND_EDITOR"
+
+	envNoColors = "BOND_NO_COLORS"
+	envNoLevel  = "BOND_NO_LEVEL"
+)
+
+// DefaultSkillsDir is the project skills directory relative to the project root.
+const DefaultSkillsDir = ".agents/skills"
+
+const settingsHeader = "# Bond configuration. Manage with bond config set.\n"
+
+// SettingKeys lists the supported setting keys in display order.
+var SettingKeys = []string{KeyStores, KeySyncMode, KeySkillsDir, KeyColor, KeyNoLevel, KeyEditor}
+
+// StoreSetting is one store entry in config.yaml.
+type StoreSetting struct {
+	Name string `yaml:"name"`
+	Path string `yaml:"path"`
+}
+
+// Settings holds the values read from config.yaml. Empty fields are unset.
+type Settings struct {
+	Stores    []StoreSetting `yaml:"stores,omitempty"`
+	SyncMode  string         `yaml:"sync_mode,omitempty"`
+	SkillsDir string         `yaml:"skills_dir,omitempty"`
+	Color     string         `yaml:"color,omitempty"`
+	NoLevel   *bool          `yaml:"no_level,omitempty"`
+	Editor    string         `yaml:"editor,omitempty"`
+}
+
+// SettingsFile returns the global config.yaml path. It lives at the top of the
+// default store directory, where Discover never looks for skills.
+func SettingsFile() (string, error) {
+	dir, err := DefaultStoreDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "config.yaml"), nil
+}
+
+// LoadSettings reads the global config.yaml. A missing file yields empty settings.
+func LoadSettings() (Settings, error) {
+	path, err := SettingsFile()
+	if err != nil {
+		return Settings{}, err
+	}
+	return LoadSettingsFrom(path)
+}
+
+// LoadSettingsFrom reads and validates settings at path. A missing file yields empty settings.
+func LoadSettingsFrom(path string) (Settings, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		// A parent that is not a directory cannot hold a config file either.
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.ENOTDIR) {
+			return Settings{}, nil
+		}
+		return Settings{}, err
+	}
+
+	settings := Settings{}
+	if err := yaml.Unmarshal(raw, &settings); err != nil {
+		return Settings{}, fmt.Errorf("invalid config %q: %w", path, err)
+	}
+	if err := settings.validate(); err != nil {
+		return Settings{}, fmt.Errorf("invalid config %q: %w", path, err)
+	}
+	return settings, nil
+}
+
+// SaveSettings writes settings to path, creating its directory when needed.
+func SaveSettings(path string, settings Settings) error {
+	if err := settings.validate(); err != nil {
+		return err
+	}
+
+	raw, err := yaml.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte(settingsHeader), raw...), 0o644)
+}
+
+// Get returns the config.yaml value for key formatted as it is accepted by Set.
+// The second result reports whether the key is set.
+func (s Settings) Get(key string) (string, bool, error) {
+	switch key {
+	case KeyStores:
+		if len(s.Stores) == 0 {
+			return "", false, nil
+		}
+		return formatStoreSettings(s.Stores), true, nil
+	case KeySyncMode:
+		return s.SyncMode, s.SyncMode != "", nil
+	case KeySkillsDir:
+		return s.SkillsDir, s.SkillsDir != "", nil
+	case KeyColor:
+		return s.Color, s.Color != "", nil
+	case KeyNoLevel:
+		if s.NoLevel == nil {
+			return "", false, nil
+		}
+		return strconv.FormatBool(*s.NoLevel), true, nil
+	case KeyEditor:
+		return s.Editor, s.Editor != "", nil
+	default:
+		return "", false, unknownKeyError(key)
+	}
+}
+
+// Set validates value and assigns it to key. An empty value unsets the key.
+func (s *Settings) Set(key, value string) error {
+	value = strings.TrimSpace(value)
+	switch key {
+	case KeyStores:
+		if value == "" {
+			s.Stores = nil
+			return nil
+		}
+		stores, err := ParseStores(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+		s.Stores = make([]StoreSetting, 0, len(stores))
+		for _, store := range stores {
+			s.Stores = append(s.Stores, StoreSetting{Name: store.Name, Path: store.Path})
+		}
+	case KeySyncMode:
+		if err := validateSyncMode(value); err != nil {
+			return err
+		}
+		s.SyncMode = value
+	case KeySkillsDir:
+		if err := validateSkillsDir(value); err != nil {
+			return err
+		}
+		s.SkillsDir = value
+	case KeyColor:
+		if err := validateColor(value); err != nil {
+			return err
+		}
+		s.Color = value
+	case KeyNoLevel:
+		if value == "" {
+			s.NoLevel = nil
+			return nil
+		}
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %q (want a boolean)", key, value)
+		}
+		s.NoLevel = &parsed
+	case KeyEditor:
+		s.Editor = value
+	default:
+		return unknownKeyError(key)
+	}
+	return nil
+}
+
+// validate rejects values that Set would not accept.
+func (s Settings) validate() error {
+	seen := map[string]struct{}{}
+	for _, store := range s.Stores {
+		if strings.TrimSpace(store.Name) == "" || strings.TrimSpace(store.Path) == "" {
+			return fmt.Errorf("store entries need a name and a path")
+		}
+		if _, exists := seen[store.Name]; exists {
+			return fmt.Errorf("duplicate store name %q", store.Name)
+		}
+		seen[store.Name] = struct{}{}
+	}
+	if err := validateSyncMode(s.SyncMode); err != nil {
+		return err
+	}
+	if err := validateSkillsDir(s.SkillsDir); err != nil {
+		return err
+	}
+	return validateColor(s.Color)
+}
+
+func validateSyncMode(value string) error {
+	switch value {
+	case "", "link", "copy":
+		return nil
+	default:
+		return fmt.Errorf("invalid %s: %q (want link or copy)", KeySyncMode, value)
+	}
+}
+
+// validateSkillsDir accepts only relative paths that stay inside the project root.
+func validateSkillsDir(value string) error {
+	if value == "" {
+		return nil
+	}
+	clean := filepath.Clean(value)
+	if filepath.IsAbs(clean) || clean == "." || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("invalid %s: %q (want a relative path inside the project)", KeySkillsDir, value)
+	}
+	return nil
+}
+
+func validateColor(value string) error {
+	switch value {
+	case "", "auto", "always", "never":
+		return nil
+	default:
+		return fmt.Errorf("invalid %s: %q (want auto, always, or never)", KeyColor, value)
+	}
+}
+
+func unknownKeyError(key string) error {
+	keys := append([]string(nil), SettingKeys...)
+	sort.Strings(keys)
+	return fmt.Errorf("unknown config key %q (want one of %s)", key, strings.Join(keys, ", "))
+}
+
+// formatStoreSettings renders stores in the name=path list syntax used by BOND_STORES.
+func formatStoreSettings(stores []StoreSetting) string {
+	parts := make([]string, 0, len(stores))
+	for _, store := range stores {
+		parts = append(parts, store.Name+"="+store.Path)
+	}
+	return strings.Join(parts, string(os.PathListSeparator))
+}
+
+// Setting sources reported by Resolve.
+const (
+	SourceConfig  = "config"
+	SourceDefault = "default"
+)
+
+// ResolvedSetting is the effective value of a setting and where it came from.
+type ResolvedSetting struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Source string `json:"source"`
+}
+
+// Resolve returns the effective value of key from the environment, then
+// settings, then the built-in default. Command-line flags are applied by callers.
+func Resolve(settings Settings, key string) (ResolvedSetting, error) {
+	resolved := ResolvedSetting{Key: key}
+
+	env, fallback := "", ""
+	switch key {
+	case KeyStores:
+		env = EnvStores
+		dir, err := DefaultStoreDir()
+		if err != nil {
+			return ResolvedSetting{}, err
+		}
+		fallback = DefaultStoreName + "=" + dir
+	case KeySyncMode:
+		env, fallback = EnvSyncMode, "link"
+	case KeySkillsDir:
+		env, fallback = EnvSkillsDir, DefaultSkillsDir
+	case KeyColor:
+		// BOND_NO_COLORS disables color whenever it is set, whatever its value.
+		if _, ok := os.LookupEnv(envNoColors); ok {
+			resolved.Value, resolved.Source = "never", "env "+envNoColors
+			return resolved, nil
+		}
+		fallback = "auto"
+	case KeyNoLevel:
+		env, fallback = envNoLevel, "false"
+	case KeyEditor:
+		env = EnvEditor
+	default:
+		return ResolvedSetting{}, unknownKeyError(key)
+	}
+
+	if env != "" {
+		if raw := strings.TrimSpace(os.Getenv(env)); raw != "" {
+			probe := Settings{}
+			if err := probe.Set(key, raw); err != nil {
+				return ResolvedSetting{}, fmt.Errorf("invalid value for %s: %q", env, raw)
+			}
+			resolved.Value, resolved.Source = raw, "env "+env
+			return resolved, nil
+		}
+	}
+
+	value, ok, err := settings.Get(key)
+	if err != nil {
+		return ResolvedSetting{}, err
+	}
+	if ok {
+		resolved.Value, resolved.Source = value, SourceConfig
+		return resolved, nil
+	}
+
+	// EDITOR is the conventional fallback and ranks below an explicit editor setting.
+	if key == KeyEditor {
+		if editor := strings.TrimSpace(os.Getenv("EDITOR")); editor != "" {
+			resolved.Value, resolved.Source = editor, "env EDITOR"
+			return resolved, nil
+		}
+	}
+
+	resolved.Value, resolved.Source = fallback, SourceDefault
+	return resolved, nil
+}
+
+// ResolveSetting loads config.yaml and resolves key.
+func ResolveSetting(key string) (ResolvedSetting, error) {
+	settings, err := LoadSettings()
+	if err != nil {
+		return ResolvedSetting{}, err
+	}
+	return Resolve(settings, key)
+}
@@ -0,0 +1,184 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSettingsSaveAndLoadRoundTrip ensures config.yaml keeps every setting.
+func TestSettingsSaveAndLoadRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bond", "config.yaml")
+
+	settings := Settings{}
+	values := map[string]string{
+		KeyStores:    "team=/srv/team",
+		KeySyncMode:  "copy",
+		KeySkillsDir: "skills",
+		KeyColor:     "never",
+		KeyNoLevel:   "true",
+		KeyEditor:    "code -w",
+	}
+	for key, value := range values {
+		if err := settings.Set(key, value); err != nil {
+			t.Fatalf("Set(%q) error = %v", key, err)
+		}
+	}
+	if err := SaveSettings(path, settings); err != nil {
+		t.Fatalf("SaveSettings() error = %v", err)
+	}
+
+	loaded, err := LoadSettingsFrom(path)
+	if err != nil {
+		t.Fatalf("LoadSettingsFrom() error = %v", err)
+	}
+	for key, want := range values {
+		got, ok, err := loaded.Get(key)
+		if err != nil || !ok || got != want {
+			t.Fatalf("Get(%q) = %q, %v, %v; want %q", key, got, ok, err, want)
+		}
+	}
+}
+
+// TestLoadSettingsMissingFileIsEmpty ensures bond works without a config file.
+func TestLoadSettingsMissingFileIsEmpty(t *testing.T) {
+	settings, err := LoadSettingsFrom(filepath.Join(t.TempDir(), "config.yaml"))
+	if err != nil {
+		t.Fatalf("LoadSettingsFrom() error = %v", err)
+	}
+	if _, ok, _ := settings.Get(KeyColor); ok {
+		t.Fatal("Get(color) ok = true, want unset")
+	}
+}
+
+// TestSettingsSetRejectsInvalidValues covers per-key validation.
+func TestSettingsSetRejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		key   string
+		value string
+	}{
+		{key: KeySyncMode, value: "move"},
+		{key: KeySkillsDir, value: "/abs/skills"},
+		{key: KeySkillsDir, value: "../outside"},
+		{key: KeyColor, value: "sometimes"},
+		{key: KeyNoLevel, value: "maybe"},
+		{key: "unknown", value: "x"},
+	}
+
+	for _, tt := range tests {
+		settings := Settings{}
+		if err := settings.Set(tt.key, tt.value); err == nil {
+			t.Fatalf("Set(%q, %q) error = nil, want non-nil", tt.key, tt.value)
+		}
+	}
+}
+
+// TestLoadSettingsRejectsInvalidFile ensures hand-edited files are validated.
+func TestLoadSettingsRejectsInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("sync_mode: move\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	_, err := LoadSettingsFrom(path)
+	if err == nil || !strings.Contains(err.Error(), "invalid config") {
+		t.Fatalf("LoadSettingsFrom() error = %v, want invalid config", err)
+	}
+}
+
+// TestResolvePrecedence ensures environment beats config.yaml, which beats defaults.
+func TestResolvePrecedence(t *testing.T) {
+	t.Setenv(EnvSyncMode, "")
+	settings := Settings{SyncMode: "copy"}
+
+	resolved, err := Resolve(Settings{}, KeySyncMode)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if resolved.Value != "link" || resolved.Source != SourceDefault {
+		t.Fatalf("Resolve(default) = %+v, want link from default", resolved)
+	}
+
+	resolved, err = Resolve(settings, KeySyncMode)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if resolved.Value != "copy" || resolved.Source != SourceConfig {
+		t.Fatalf("Resolve(config) = %+v, want copy from config", resolved)
+	}
+
+	t.Setenv(EnvSyncMode, "link")
+	resolved, err = Resolve(settings, KeySyncMode)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if resolved.Value != "link" || resolved.Source != "env "+EnvSyncMode {
+		t.Fatalf("Resolve(env) = %+v, want link from env", resolved)
+	}
+
+	t.Setenv(EnvSyncMode, "move")
+	if _, err := Resolve(settings, KeySyncMode); err == nil {
+		t.Fatal("Resolve(invalid env) error = nil, want non-nil")
+	}
+}
+
+// TestResolveEditorRanksConfigAboveEDITOR ensures the editor override beats EDITOR.
+func TestResolveEditorRanksConfigAboveEDITOR(t *testing.T) {
+	t.Setenv(EnvEditor, "")
+	t.Setenv("EDITOR", "vi")
+
+	resolved, err := Resolve(Settings{}, KeyEditor)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if resolved.Value != "vi" || resolved.Source != "env EDITOR" {
+		t.Fatalf("Resolve() = %+v, want vi from EDITOR", resolved)
+	}
+
+	resolved, err = Resolve(Settings{Editor: "code -w"}, KeyEditor)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if resolved.Value != "code -w" || resolved.Source != SourceConfig {
+		t.Fatalf("Resolve() = %+v, want config editor", resolved)
+	}
+
+	t.Setenv(EnvEditor, "nano")
+	resolved, err = Resolve(Settings{Editor: "code -w"}, KeyEditor)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if resolved.Value != "nano" {
+		t.Fatalf("Resolve() = %+v, want BOND_EDITOR", resolved)
+	}
+}
+
+// TestStoresAndSkillsDirFromConfig ensures config.yaml feeds store and skills dir lookup.
+func TestStoresAndSkillsDirFromConfig(t *testing.T) {
+	xdg := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	t.Setenv(EnvStores, "")
+	t.Setenv(EnvSkillsDir, "")
+
+	settings := Settings{SkillsDir: "skills", Stores: []StoreSetting{{Name: "team", Path: "/srv/team"}}}
+	if err := SaveSettings(filepath.Join(xdg, "bond", "config.yaml"), settings); err != nil {
+		t.Fatalf("SaveSettings() error = %v", err)
+	}
+
+	stores, err := Stores()
+	if err != nil {
+		t.Fatalf("Stores() error = %v", err)
+	}
+	if len(stores) != 1 || stores[0] != (Store{Name: "team", Path: "/srv/team"}) {
+		t.Fatalf("Stores() = %+v, want team store", stores)
+	}
+
+	name, err := ProjectSkillsDirName()
+	if err != nil {
+		t.Fatalf("ProjectSkillsDirName() error = %v", err)
+	}
+	if name != "skills" {
+		t.Fatalf("ProjectSkillsDirName() = %q, want skills", name)
+	}
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// EnvStores lists stores in priority order as name=path entries separated
+	// by the OS path list separator.
+	EnvStores = "BOND_STORES"
+	// DefaultStoreName names the XDG store when no store list is configured.
+	DefaultStoreName = "default"
+)
+
+// Store is one named skills store in the search path.
+type Store struct {
+	Name string
+	Path string
+}
+
+// Stores returns the ordered store search path from BOND_STORES, then
+// config.yaml, then the default store. Earlier stores take priority when the
+// same skill name exists in several stores.
+func Stores() ([]Store, error) {
+	if raw := strings.TrimSpace(os.Getenv(EnvStores)); raw != "" {
+		stores, err := ParseStores(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvStores, err)
+		}
+		return stores, nil
+	}
+
+	settings, err := LoadSettings()
+	if err != nil {
+		return nil, err
+	}
+	if len(settings.Stores) > 0 {
+		stores := make([]Store, 0, len(settings.Stores))
+		for _, store := range settings.Stores {
+			stores = append(stores, Store{Name: store.Name, Path: expandHome(store.Path)})
+		}
+		return stores, nil
+	}
+
+	dir, err := DefaultStoreDir()
+	if err != nil {
+		return nil, err
+	}
+	return []Store{{Name: DefaultStoreName, Path: dir}}, nil
+}
+
+// ParseStores parses a store list. Entries are name=path; a bare path is named
+// after its base directory.
+func ParseStores(raw string) ([]Store, error) {
+	stores := []Store{}
+	seen := map[string]struct{}{}
+	for _, part := range filepath.SplitList(raw) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, path, ok := strings.Cut(part, "=")
+		if !ok {
+			name, path = filepath.Base(part), part
+		}
+		name, path = strings.TrimSpace(name), strings.TrimSpace(path)
+		if name == "" || path == "" {
+			return nil, fmt.Errorf("store entry %q needs a name and a path", part)
+		}
+		if strings.Contains(name, ":") {
+			return nil, fmt.Errorf("store name %q must not contain ':'", name)
+		}
+		if _, exists := seen[name]; exists {
+			return nil, fmt.Errorf("duplicate store name %q", name)
+		}
+		seen[name] = struct{}{}
+
+		abs, err := filepath.Abs(expandHome(path))
+		if err != nil {
+			return nil, err
+		}
+		stores = append(stores, Store{Name: name, Path: abs})
+	}
+
+	if len(stores) == 0 {
+		return nil, fmt.Errorf("no stores listed")
+	}
+	return stores, nil
+}
+
+// expandHome replaces a leading ~/ with the user's home directory.
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestStoresDefaultsToXDGStore ensures the single default store is used without BOND_STORES.
+func TestStoresDefaultsToXDGStore(t *testing.T) {
+	t.Setenv(EnvStores, "")
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/xdg")
+
+	stores, err := Stores()
+	if err != nil {
+		t.Fatalf("Stores() error = %v", err)
+	}
+	want := Store{Name: DefaultStoreName, Path: filepath.Join("/tmp/xdg", "bond")}
+	if len(stores) != 1 || stores[0] != want {
+		t.Fatalf("Stores() = %+v, want [%+v]", stores, want)
+	}
+}
+
+// TestStoresFromEnvKeepsOrder ensures BOND_STORES entries keep priority order.
+func TestStoresFromEnvKeepsOrder(t *testing.T) {
+	sep := string(os.PathListSeparator)
+	t.Setenv(EnvStores, "personal=/tmp/me"+sep+"/srv/team-skills"+sep)
+
+	stores, err := Stores()
+	if err != nil {
+		t.Fatalf("Stores() error = %v", err)
+	}
+	want := []Store{
+		{Name: "personal", Path: "/tmp/me"},
+		{Name: "team-skills", Path: "/srv/team-skills"},
+	}
+	if len(stores) != len(want) {
+		t.Fatalf("Stores() = %+v, want %+v", stores, want)
+	}
+	for i := range want {
+		if stores[i] != want[i] {
+			t.Fatalf("Stores()[%d] = %+v, want %+v", i, stores[i], want[i])
+		}
+	}
+
+	primary, err := StoreSkillsDir()
+	if err != nil {
+		t.Fatalf("StoreSkillsDir() error = %v", err)
+	}
+	if primary != "/tmp/me" {
+		t.Fatalf("StoreSkillsDir() = %q, want first store", primary)
+	}
+}
+
+// TestParseStoresRejectsInvalidEntries covers malformed store lists.
+func TestParseStoresRejectsInvalidEntries(t *testing.T) {
+	sep := string(os.PathListSeparator)
+	for _, raw := range []string{
+		"a=/one" + sep + "a=/two",
+		"=/one",
+		"team=",
+		sep,
+	} {
+		if _, err := ParseStores(raw); err == nil {
+			t.Fatalf("ParseStores(%q) error = nil, want non-nil", raw)
+		}
+	}
+}
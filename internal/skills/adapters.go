@@ -0,0 +1,374 @@
+package skills
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Adapter renders a skill into a single-file rule format read by another agent.
+type Adapter interface {
+	// Name identifies the adapter in the bond.yaml adapters list.
+	Name() string
+	// OutputPath returns the slash-separated, project-relative file for skill.
+	OutputPath(skill string) string
+	// Render returns the generated content for doc.
+	Render(doc SkillDocument) (string, error)
+	// Shared reports whether every skill renders into its own managed block
+	// of one file instead of owning a whole file.
+	Shared() bool
+}
+
+// RenderStatus describes the outcome of rendering one adapter output.
+type RenderStatus string
+
+const (
+	RenderStatusRendered RenderStatus = "rendered"
+	RenderStatusUpToDate RenderStatus = "up_to_date"
+	RenderStatusModified RenderStatus = "modified"
+	RenderStatusConflict RenderStatus = "conflict"
+	RenderStatusRemoved  RenderStatus = "removed"
+)
+
+// RenderResult captures the output of RenderOutput and RemoveOutput.
+type RenderResult struct {
+	Status RenderStatus `json:"status"`
+	Path   string       `json:"path"`
+}
+
+// OutputStatus classifies a tracked adapter output against its source skill.
+type OutputStatus string
+
+const (
+	OutputStatusCurrent  OutputStatus = "current"
+	OutputStatusOutdated OutputStatus = "outdated"
+	OutputStatusModified OutputStatus = "modified"
+	OutputStatusMissing  OutputStatus = "missing"
+)
+
+// OutputStatusEntry is the inspected state of one tracked adapter output.
+type OutputStatusEntry struct {
+	Adapter string       `json:"adapter"`
+	Skill   string       `json:"skill"`
+	Path    string       `json:"path"`
+	Status  OutputStatus `json:"status"`
+}
+
+var adapters = []Adapter{cursorAdapter{}, copilotAdapter{}, agentsMDAdapter{}}
+
+// Adapters returns the built-in adapters in display order.
+func Adapters() []Adapter {
+	return append([]Adapter(nil), adapters...)
+}
+
+// LookupAdapter returns the built-in adapter called name.
+func LookupAdapter(name string) (Adapter, bool) {
+	for _, adapter := range adapters {
+		if adapter.Name() == name {
+			return adapter, true
+		}
+	}
+	return nil, false
+}
+
+// AdapterNames returns the names of the built-in adapters.
+func AdapterNames() []string {
+	names := make([]string, 0, len(adapters))
+	for _, adapter := range adapters {
+		names = append(names, adapter.Name())
+	}
+	return names
+}
+
+// OutputKey is the lockfile key for the output adapter renders for skill.
+func OutputKey(adapter, skill string) string {
+	return adapter + "/" + skill
+}
+
+// RenderOutput renders the skill at skillPath with adapter below projectRoot
+// and records the result in lock. Outputs edited since they were generated and
+// existing files bond did not generate are left untouched.
+func RenderOutput(projectRoot string, adapter Adapter, skillPath string, lock *Lockfile) (RenderResult, error) {
+	skill := filepath.Base(skillPath)
+	rel := adapter.OutputPath(skill)
+	result := RenderResult{Path: filepath.Join(projectRoot, filepath.FromSlash(rel))}
+
+	doc, err := ReadSkillDocument(skillPath)
+	if err != nil {
+		return RenderResult{}, err
+	}
+	rendered, err := adapter.Render(doc)
+	if err != nil {
+		return RenderResult{}, err
+	}
+	sourceAbs, err := filepath.Abs(skillPath)
+	if err != nil {
+		return RenderResult{}, err
+	}
+	sourceDigest, err := DigestDir(sourceAbs)
+	if err != nil {
+		return RenderResult{}, err
+	}
+
+	key := OutputKey(adapter.Name(), skill)
+	entry, tracked := lock.Outputs[key]
+	current, exists, err := readOutput(result.Path, adapter, skill)
+	if err != nil {
+		return RenderResult{}, err
+	}
+	switch {
+	case exists && tracked && digestString(current) != entry.Digest:
+		result.Status = RenderStatusModified
+		return result, nil
+	case exists && !tracked:
+		result.Status = RenderStatusConflict
+		return result, nil
+	case exists && current == rendered && entry.SourceDigest == sourceDigest && entry.Source == sourceAbs:
+		result.Status = RenderStatusUpToDate
+		return result, nil
+	}
+
+	if err := writeOutput(result.Path, adapter, skill, rendered); err != nil {
+		return RenderResult{}, err
+	}
+	if lock.Outputs == nil {
+		lock.Outputs = map[string]OutputEntry{}
+	}
+	lock.Outputs[key] = OutputEntry{
+		Adapter:      adapter.Name(),
+		Skill:        skill,
+		Path:         rel,
+		Source:       sourceAbs,
+		SourceDigest: sourceDigest,
+		Digest:       digestString(rendered),
+	}
+	result.Status = RenderStatusRendered
+	return result, nil
+}
+
+// RemoveOutput deletes the tracked output for key below projectRoot and drops
+// it from lock. Outputs edited since they were generated are kept and stay tracked.
+func RemoveOutput(projectRoot, key string, lock *Lockfile) (RenderResult, error) {
+	entry, ok := lock.Outputs[key]
+	if !ok {
+		return RenderResult{}, fmt.Errorf("no generated output recorded for %q", key)
+	}
+	adapter, ok := LookupAdapter(entry.Adapter)
+	if !ok {
+		return RenderResult{}, fmt.Errorf("unknown adapter %q", entry.Adapter)
+	}
+
+	result := RenderResult{Path: filepath.Join(projectRoot, filepath.FromSlash(entry.Path))}
+	current, exists, err := readOutput(result.Path, adapter, entry.Skill)
+	if err != nil {
+		return RenderResult{}, err
+	}
+	if exists && digestString(current) != entry.Digest {
+		result.Status = RenderStatusModified
+		return result, nil
+	}
+
+	if exists {
+		if err := deleteOutput(result.Path, adapter, entry.Skill); err != nil {
+			return RenderResult{}, err
+		}
+	}
+	delete(lock.Outputs, key)
+	result.Status = RenderStatusRemoved
+	return result, nil
+}
+
+// InspectOutputs reports whether each output tracked in lock still matches
+// what was generated and whether its source skill changed since.
+func InspectOutputs(projectRoot string, lock Lockfile) ([]OutputStatusEntry, error) {
+	keys := make([]string, 0, len(lock.Outputs))
+	for key := range lock.Outputs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]OutputStatusEntry, 0, len(keys))
+	for _, key := range keys {
+		tracked := lock.Outputs[key]
+		entry := OutputStatusEntry{
+			Adapter: tracked.Adapter,
+			Skill:   tracked.Skill,
+			Path:    filepath.Join(projectRoot, filepath.FromSlash(tracked.Path)),
+		}
+
+		adapter, ok := LookupAdapter(tracked.Adapter)
+		if !ok {
+			return nil, fmt.Errorf("unknown adapter %q in lockfile", tracked.Adapter)
+		}
+		current, exists, err := readOutput(entry.Path, adapter, tracked.Skill)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case !exists:
+			entry.Status = OutputStatusMissing
+		case digestString(current) != tracked.Digest:
+			entry.Status = OutputStatusModified
+		default:
+			sourceDigest, err := DigestDir(tracked.Source)
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return nil, err
+			}
+			// A deleted source skill leaves the output outdated too.
+			if err != nil || sourceDigest != tracked.SourceDigest {
+				entry.Status = OutputStatusOutdated
+			} else {
+				entry.Status = OutputStatusCurrent
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// outputBlockID is the managed block id of skill in a shared output file.
+func outputBlockID(skill string) string {
+	return "skill " + skill
+}
+
+// readOutput returns the generated content currently at path for skill.
+func readOutput(path string, adapter Adapter, skill string) (string, bool, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	if !adapter.Shared() {
+		return string(raw), true, nil
+	}
+	block, ok := ManagedBlock(string(raw), outputBlockID(skill))
+	return block, ok, nil
+}
+
+// writeOutput stores rendered at path, inside the skill's block for shared adapters.
+func writeOutput(path string, adapter Adapter, skill, rendered string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if !adapter.Shared() {
+		return os.WriteFile(path, []byte(rendered), 0o644)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return os.WriteFile(path, []byte(UpsertManagedBlock(string(raw), outputBlockID(skill), rendered)), 0o644)
+}
+
+// deleteOutput removes the output at path, or only the skill's block for shared adapters.
+func deleteOutput(path string, adapter Adapter, skill string) error {
+	if !adapter.Shared() {
+		return os.Remove(path)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	updated, _ := RemoveManagedBlock(string(raw), outputBlockID(skill))
+	return os.WriteFile(path, []byte(updated), 0o644)
+}
+
+func digestString(contents string) string {
+	sum := sha256.Sum256([]byte(contents))
+	return digestPrefix + hex.EncodeToString(sum[:])
+}
+
+// generatedNotice marks whole-file outputs so readers edit the store skill instead.
+func generatedNotice(skill string) string {
+	return fmt.Sprintf("<!-- Generated by bond from skill %q. Edit the skill and run bond sync. -->", skill)
+}
+
+// renderWithFrontmatter joins YAML frontmatter, the generated notice, and body.
+func renderWithFrontmatter(frontmatter any, doc SkillDocument) (string, error) {
+	raw, err := yaml.Marshal(frontmatter)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.WriteString("---\n")
+	b.Write(raw)
+	b.WriteString("---\n\n")
+	b.WriteString(generatedNotice(doc.Meta.Name))
+	b.WriteString("\n")
+	if doc.Body != "" {
+		b.WriteString("\n")
+		b.WriteString(doc.Body)
+		b.WriteString("\n")
+	}
+	return b.String(), nil
+}
+
+// cursorAdapter writes Cursor project rules to .cursor/rules/<skill>.mdc.
+// Rules are agent-requested, so Cursor decides from the description when to load them.
+type cursorAdapter struct{}
+
+func (cursorAdapter) Name() string { return "cursor" }
+
+func (cursorAdapter) OutputPath(skill string) string { return ".cursor/rules/" + skill + ".mdc" }
+
+func (cursorAdapter) Shared() bool { return false }
+
+func (cursorAdapter) Render(doc SkillDocument) (string, error) {
+	return renderWithFrontmatter(struct {
+		Description string `yaml:"description"`
+		Globs       string `yaml:"globs"`
+		AlwaysApply bool   `yaml:"alwaysApply"`
+	}{Description: doc.Meta.Description}, doc)
+}
+
+// copilotAdapter writes GitHub Copilot instruction files to
+// .github/instructions/<skill>.instructions.md.
+type copilotAdapter struct{}
+
+func (copilotAdapter) Name() string { return "copilot" }
+
+func (copilotAdapter) OutputPath(skill string) string {
+	return ".github/instructions/" + skill + ".instructions.md"
+}
+
+func (copilotAdapter) Shared() bool { return false }
+
+func (copilotAdapter) Render(doc SkillDocument) (string, error) {
+	return renderWithFrontmatter(struct {
+		ApplyTo     string `yaml:"applyTo"`
+		Description string `yaml:"description,omitempty"`
+	}{ApplyTo: "**", Description: doc.Meta.Description}, doc)
+}
+
+// agentsMDAdapter writes one section per skill into the project AGENTS.md.
+type agentsMDAdapter struct{}
+
+func (agentsMDAdapter) Name() string { return "agents-md" }
+
+func (agentsMDAdapter) OutputPath(string) string { return "AGENTS.md" }
+
+func (agentsMDAdapter) Shared() bool { return true }
+
+func (agentsMDAdapter) Render(doc SkillDocument) (string, error) {
+	parts := []string{"## " + doc.Meta.Name}
+	if doc.Meta.Description != "" {
+		parts = append(parts, doc.Meta.Description)
+	}
+	if doc.Body != "" {
+		parts = append(parts, doc.Body)
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
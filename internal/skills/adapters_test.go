@@ -0,0 +1,126 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderOutputTracksAdapterOutputs(t *testing.T) {
+	tmp := t.TempDir()
+	skillDir := filepath.Join(tmp, "store", "go")
+	projectRoot := filepath.Join(tmp, "project")
+	mustMkdirAll(t, skillDir)
+	mustMkdirAll(t, projectRoot)
+	mustWriteFile(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: go\ndescription: Go conventions\n---\n\nUse gofmt.\n")
+	mustWriteFile(t, filepath.Join(projectRoot, "AGENTS.md"), "# Notes\n")
+
+	lock := Lockfile{}
+	for _, adapter := range Adapters() {
+		result, err := RenderOutput(projectRoot, adapter, skillDir, &lock)
+		if err != nil {
+			t.Fatalf("RenderOutput(%s) error = %v", adapter.Name(), err)
+		}
+		if result.Status != RenderStatusRendered {
+			t.Fatalf("RenderOutput(%s) status = %q, want rendered", adapter.Name(), result.Status)
+		}
+	}
+
+	mdc, err := os.ReadFile(filepath.Join(projectRoot, ".cursor", "rules", "go.mdc"))
+	if err != nil {
+		t.Fatalf("ReadFile(go.mdc) error = %v", err)
+	}
+	if !strings.HasPrefix(string(mdc), "---\ndescription: Go conventions\n") || !strings.HasSuffix(string(mdc), "\nUse gofmt.\n") {
+		t.Fatalf("go.mdc = %q", mdc)
+	}
+	agents, err := os.ReadFile(filepath.Join(projectRoot, "AGENTS.md"))
+	if err != nil {
+		t.Fatalf("ReadFile(AGENTS.md) error = %v", err)
+	}
+	if !strings.HasPrefix(string(agents), "# Notes\n\n<!-- bond:begin skill go -->\n## go\n\nGo conventions\n\nUse gofmt.\n") {
+		t.Fatalf("AGENTS.md = %q", agents)
+	}
+
+	cursor, _ := LookupAdapter("cursor")
+	result, err := RenderOutput(projectRoot, cursor, skillDir, &lock)
+	if err != nil || result.Status != RenderStatusUpToDate {
+		t.Fatalf("second RenderOutput() = %+v, %v, want up_to_date", result, err)
+	}
+
+	mustWriteFile(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: go\ndescription: Go conventions\n---\n\nUse gofmt and vet.\n")
+	mustWriteFile(t, filepath.Join(projectRoot, ".github", "instructions", "go.instructions.md"), "edited\n")
+	if err := os.Remove(filepath.Join(projectRoot, "AGENTS.md")); err != nil {
+		t.Fatalf("Remove(AGENTS.md) error = %v", err)
+	}
+
+	entries, err := InspectOutputs(projectRoot, lock)
+	if err != nil {
+		t.Fatalf("InspectOutputs() error = %v", err)
+	}
+	got := map[string]OutputStatus{}
+	for _, entry := range entries {
+		got[entry.Adapter] = entry.Status
+	}
+	want := map[string]OutputStatus{"agents-md": OutputStatusMissing, "copilot": OutputStatusModified, "cursor": OutputStatusOutdated}
+	for adapter, status := range want {
+		if got[adapter] != status {
+			t.Fatalf("status[%s] = %q, want %q (all: %v)", adapter, got[adapter], status, got)
+		}
+	}
+
+	copilot, _ := LookupAdapter("copilot")
+	result, err = RenderOutput(projectRoot, copilot, skillDir, &lock)
+	if err != nil || result.Status != RenderStatusModified {
+		t.Fatalf("RenderOutput(edited) = %+v, %v, want modified", result, err)
+	}
+}
+
+func TestRenderOutputLeavesUnmanagedFiles(t *testing.T) {
+	tmp := t.TempDir()
+	skillDir := filepath.Join(tmp, "store", "go")
+	projectRoot := filepath.Join(tmp, "project")
+	mustMkdirAll(t, skillDir)
+	mustMkdirAll(t, projectRoot)
+	mustWriteFile(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: go\ndescription: Go\n---\n")
+	mustMkdirAll(t, filepath.Join(projectRoot, ".cursor", "rules"))
+	mustWriteFile(t, filepath.Join(projectRoot, ".cursor", "rules", "go.mdc"), "mine\n")
+
+	cursor, _ := LookupAdapter("cursor")
+	lock := Lockfile{}
+	result, err := RenderOutput(projectRoot, cursor, skillDir, &lock)
+	if err != nil || result.Status != RenderStatusConflict {
+		t.Fatalf("RenderOutput() = %+v, %v, want conflict", result, err)
+	}
+	if len(lock.Outputs) != 0 {
+		t.Fatalf("lock.Outputs = %v, want empty", lock.Outputs)
+	}
+}
+
+func TestRemoveOutputDeletesSharedBlock(t *testing.T) {
+	tmp := t.TempDir()
+	skillDir := filepath.Join(tmp, "store", "go")
+	projectRoot := filepath.Join(tmp, "project")
+	mustMkdirAll(t, skillDir)
+	mustMkdirAll(t, projectRoot)
+	mustWriteFile(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: go\ndescription: Go\n---\n")
+	mustWriteFile(t, filepath.Join(projectRoot, "AGENTS.md"), "# Notes\n")
+
+	agentsMD, _ := LookupAdapter("agents-md")
+	lock := Lockfile{}
+	if _, err := RenderOutput(projectRoot, agentsMD, skillDir, &lock); err != nil {
+		t.Fatalf("RenderOutput() error = %v", err)
+	}
+
+	result, err := RemoveOutput(projectRoot, OutputKey("agents-md", "go"), &lock)
+	if err != nil || result.Status != RenderStatusRemoved {
+		t.Fatalf("RemoveOutput() = %+v, %v, want removed", result, err)
+	}
+	raw, err := os.ReadFile(filepath.Join(projectRoot, "AGENTS.md"))
+	if err != nil || string(raw) != "# Notes\n" {
+		t.Fatalf("AGENTS.md = %q, %v, want original notes", raw, err)
+	}
+	if len(lock.Outputs) != 0 {
+		t.Fatalf("lock.Outputs = %v, want empty", lock.Outputs)
+	}
+}
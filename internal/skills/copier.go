@@ -18,32 +18,23 @@ const (
 
 // CopyResult wraps the final status for a copy operation.
 type CopyResult struct {
-	Status CopyStatus
+	Status CopyStatus `json:"status"`
 }
 
 // Copy recursively copies sourcePath into destPath when destPath does not exist.
 func Copy(sourcePath, destPath string) (CopyResult, error) {
-	sourceAbs, err := filepath.Abs(sourcePath)
+	sourceAbs, sourceInfo, err := statSourceDir(sourcePath)
 	if err != nil {
 		return CopyResult{}, err
 	}
 
-	sourceInfo, err := os.Stat(sourceAbs)
-	if err != nil {
-		return CopyResult{}, fmt.Errorf("source missing %q: %w", sourceAbs, err)
-	}
-	if !sourceInfo.IsDir() {
-		return CopyResult{}, fmt.Errorf("source is not a directory %q", sourceAbs)
-	}
-
 	if _, err := os.Lstat(destPath); err == nil {
 		return CopyResult{Status: CopyStatusConflict}, nil
 	} else if !os.IsNotExist(err) {
 		return CopyResult{}, err
 	}
 
-	parent := filepath.Dir(destPath)
-	tmpDir, err := os.MkdirTemp(parent, "."+filepath.Base(destPath)+".tmp-*")
+	tmpDir, err := stageCopy(sourceAbs, sourceInfo, destPath)
 	if err != nil {
 		return CopyResult{}, err
 	}
@@ -54,11 +45,146 @@ func Copy(sourcePath, destPath string) (CopyResult, error) {
 		}
 	}()
 
-	if err := os.Chmod(tmpDir, sourceInfo.Mode().Perm()); err != nil {
+	if err := os.Rename(tmpDir, destPath); err != nil {
+		if _, statErr := os.Lstat(destPath); statErr == nil {
+			return CopyResult{Status: CopyStatusConflict}, nil
+		}
 		return CopyResult{}, err
 	}
 
-	if err := filepath.WalkDir(sourceAbs, func(path string, d fs.DirEntry, walkErr error) error {
+	success = true
+	return CopyResult{Status: CopyStatusCopied}, nil
+}
+
+// PlanCopy reports what Copy would do without touching disk. The operations
+// are empty unless the status is copied.
+func PlanCopy(sourcePath, destPath string) (CopyResult, []Operation, error) {
+	sourceAbs, _, err := statSourceDir(sourcePath)
+	if err != nil {
+		return CopyResult{}, nil, err
+	}
+
+	if _, err := os.Lstat(destPath); err == nil {
+		return CopyResult{Status: CopyStatusConflict}, nil, nil
+	} else if !os.IsNotExist(err) {
+		return CopyResult{}, nil, err
+	}
+	return CopyResult{Status: CopyStatusCopied}, []Operation{{Kind: OpCopyTree, Path: destPath, Source: sourceAbs}}, nil
+}
+
+// Replace atomically swaps an existing destPath for a fresh copy of sourcePath.
+// The previous tree is moved to backupPath when set, or deleted otherwise.
+func Replace(sourcePath, destPath, backupPath string) error {
+	sourceAbs, sourceInfo, err := statSourceDir(sourcePath)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Lstat(destPath); err != nil {
+		return err
+	}
+
+	tmpDir, err := stageCopy(sourceAbs, sourceInfo, destPath)
+	if err != nil {
+		return err
+	}
+	if err := swapIntoPlace(tmpDir, destPath, backupPath); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return err
+	}
+	return nil
+}
+
+// SnapshotBase stores a pristine copy of sourcePath as baseDir/name, replacing
+// any previous snapshot. Snapshots serve as the merge base for copied skills.
+func SnapshotBase(sourcePath, baseDir, name string) error {
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		return err
+	}
+
+	dest := filepath.Join(baseDir, name)
+	result, err := Copy(sourcePath, dest)
+	if err != nil {
+		return err
+	}
+	if result.Status == CopyStatusConflict {
+		return Replace(sourcePath, dest, "")
+	}
+	return nil
+}
+
+// swapIntoPlace renames stagedDir over an existing destPath. The previous tree
+// is moved to backupPath when set, or deleted otherwise.
+func swapIntoPlace(stagedDir, destPath, backupPath string) error {
+	retired := backupPath
+	if retired == "" {
+		var err error
+		retired, err = os.MkdirTemp(filepath.Dir(destPath), "."+filepath.Base(destPath)+".old-*")
+		if err != nil {
+			return err
+		}
+		// Rename needs a free path; the temp name only reserves a unique one.
+		if err := os.Remove(retired); err != nil {
+			return err
+		}
+	} else if err := os.MkdirAll(filepath.Dir(retired), 0o755); err != nil {
+		return err
+	}
+
+	if err := os.Rename(destPath, retired); err != nil {
+		return err
+	}
+	if err := os.Rename(stagedDir, destPath); err != nil {
+		// Put the previous tree back so a failed swap leaves destPath untouched.
+		_ = os.Rename(retired, destPath)
+		return err
+	}
+
+	if backupPath == "" {
+		return os.RemoveAll(retired)
+	}
+	return nil
+}
+
+func statSourceDir(sourcePath string) (string, os.FileInfo, error) {
+	sourceAbs, err := filepath.Abs(sourcePath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	sourceInfo, err := os.Stat(sourceAbs)
+	if err != nil {
+		return "", nil, fmt.Errorf("source missing %q: %w", sourceAbs, err)
+	}
+	if !sourceInfo.IsDir() {
+		return "", nil, fmt.Errorf("source is not a directory %q", sourceAbs)
+	}
+	return sourceAbs, sourceInfo, nil
+}
+
+// stageCopy copies sourceAbs into a hidden temp directory next to destPath so
+// the caller can move it into place with a single rename.
+func stageCopy(sourceAbs string, sourceInfo os.FileInfo, destPath string) (string, error) {
+	parent := filepath.Dir(destPath)
+	tmpDir, err := os.MkdirTemp(parent, "."+filepath.Base(destPath)+".tmp-*")
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.Chmod(tmpDir, sourceInfo.Mode().Perm()); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return "", err
+	}
+
+	if err := copyTree(sourceAbs, tmpDir); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return "", err
+	}
+	return tmpDir, nil
+}
+
+func copyTree(sourceAbs, destDir string) error {
+	return filepath.WalkDir(sourceAbs, func(path string, d fs.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return walkErr
 		}
@@ -71,7 +197,7 @@ func Copy(sourcePath, destPath string) (CopyResult, error) {
 			return nil
 		}
 
-		destEntry := filepath.Join(tmpDir, rel)
+		destEntry := filepath.Join(destDir, rel)
 		info, err := d.Info()
 		if err != nil {
 			return err
@@ -92,19 +218,7 @@ func Copy(sourcePath, destPath string) (CopyResult, error) {
 		default:
 			return fmt.Errorf("unsupported file type %q", path)
 		}
-	}); err != nil {
-		return CopyResult{}, err
-	}
-
-	if err := os.Rename(tmpDir, destPath); err != nil {
-		if _, statErr := os.Lstat(destPath); statErr == nil {
-			return CopyResult{Status: CopyStatusConflict}, nil
-		}
-		return CopyResult{}, err
-	}
-
-	success = true
-	return CopyResult{Status: CopyStatusCopied}, nil
+	})
 }
 
 func copyFile(sourcePath, destPath string, mode fs.FileMode) error {
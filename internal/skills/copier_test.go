@@ -125,3 +125,75 @@ func TestCopyReturnsErrorWhenSourceIsNotDirectory(t *testing.T) {
 		t.Fatal("Copy() error = nil, want non-nil")
 	}
 }
+
+func TestReplaceSwapsDestinationContents(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "global", "go")
+	dest := filepath.Join(tmp, "project", ".agents", "skills", "go")
+
+	mustMkdirAll(t, source)
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "new")
+	mustMkdirAll(t, dest)
+	mustWriteFile(t, filepath.Join(dest, "SKILL.md"), "old")
+	mustWriteFile(t, filepath.Join(dest, "stale.txt"), "stale")
+
+	if err := Replace(source, dest, ""); err != nil {
+		t.Fatalf("Replace() error = %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dest, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile(dest/SKILL.md) error = %v", err)
+	}
+	if string(got) != "new" {
+		t.Fatalf("SKILL.md contents = %q, want %q", string(got), "new")
+	}
+	if _, err := os.Stat(filepath.Join(dest, "stale.txt")); !os.IsNotExist(err) {
+		t.Fatalf("stale.txt should be gone, err = %v", err)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(dest))
+	if err != nil {
+		t.Fatalf("ReadDir(dest parent) error = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("dest parent has %d entries, want only the replaced skill", len(entries))
+	}
+}
+
+func TestReplaceMovesPreviousTreeToBackup(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "project", "go")
+	dest := filepath.Join(tmp, "store", "go")
+	backup := filepath.Join(tmp, "store", ".bond", "backups", "go-1")
+
+	mustMkdirAll(t, source)
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "new")
+	mustMkdirAll(t, dest)
+	mustWriteFile(t, filepath.Join(dest, "SKILL.md"), "old")
+
+	if err := Replace(source, dest, backup); err != nil {
+		t.Fatalf("Replace() error = %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(backup, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile(backup/SKILL.md) error = %v", err)
+	}
+	if string(got) != "old" {
+		t.Fatalf("backup SKILL.md = %q, want %q", string(got), "old")
+	}
+}
+
+func TestReplaceReturnsErrorWhenDestinationMissing(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "global", "go")
+	dest := filepath.Join(tmp, "project", "go")
+
+	mustMkdirAll(t, source)
+	mustMkdirAll(t, filepath.Dir(dest))
+
+	if err := Replace(source, dest, ""); !os.IsNotExist(err) {
+		t.Fatalf("Replace() error = %v, want not-exist error", err)
+	}
+}
@@ -0,0 +1,356 @@
+package skills
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	diffContextLines = 3
+	// maxDiffCells bounds the LCS table so pathological inputs fall back to a full replace.
+	maxDiffCells = 4 << 20
+)
+
+// FileChangeKind describes how a file differs between two skill trees.
+type FileChangeKind string
+
+const (
+	FileAdded    FileChangeKind = "added"
+	FileRemoved  FileChangeKind = "removed"
+	FileModified FileChangeKind = "modified"
+)
+
+// FileChange describes one differing file between an old and a new skill tree.
+type FileChange struct {
+	// Path is slash-separated and relative to the skill directory.
+	Path   string         `json:"path"`
+	Kind   FileChangeKind `json:"kind"`
+	Binary bool           `json:"binary"`
+	// Diff holds a unified diff for text files and is empty for binary files.
+	Diff string `json:"diff,omitempty"`
+}
+
+// treeFile is one comparable entry in a skill tree: file contents or a symlink target.
+type treeFile struct {
+	contents []byte
+	symlink  bool
+	mode     fs.FileMode
+}
+
+// DiffTrees compares oldDir with newDir and returns file changes sorted by path.
+// Files only in newDir are added, and files only in oldDir are removed.
+func DiffTrees(oldDir, newDir, oldLabel, newLabel string) ([]FileChange, error) {
+	oldFiles, err := readTreeFiles(oldDir)
+	if err != nil {
+		return nil, err
+	}
+	newFiles, err := readTreeFiles(newDir)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(oldFiles)+len(newFiles))
+	for path := range oldFiles {
+		paths = append(paths, path)
+	}
+	for path := range newFiles {
+		if _, ok := oldFiles[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := []FileChange{}
+	for _, path := range paths {
+		oldFile, inOld := oldFiles[path]
+		newFile, inNew := newFiles[path]
+
+		change := FileChange{Path: path}
+		switch {
+		case !inOld:
+			change.Kind = FileAdded
+		case !inNew:
+			change.Kind = FileRemoved
+		case oldFile.symlink == newFile.symlink && bytes.Equal(oldFile.contents, newFile.contents):
+			continue
+		default:
+			change.Kind = FileModified
+		}
+
+		oldText := renderTreeFile(oldFile)
+		newText := renderTreeFile(newFile)
+		if !isText(oldText) || !isText(newText) {
+			change.Binary = true
+		} else {
+			oldName, newName := oldLabel+"/"+path, newLabel+"/"+path
+			if !inOld {
+				oldName = "/dev/null"
+			}
+			if !inNew {
+				newName = "/dev/null"
+			}
+			change.Diff = UnifiedDiff(oldName, newName, string(oldText), string(newText))
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// UnifiedDiff renders a line-based unified diff between oldText and newText.
+// It returns an empty string when both texts are equal.
+func UnifiedDiff(oldName, newName, oldText, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+
+	oldLines := splitLines(oldText)
+	newLines := splitLines(newText)
+	edits := lineEdits(oldLines, newLines)
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", oldName, newName)
+
+	for start := 0; start < len(edits); {
+		// Find the next change and open a hunk with leading context.
+		for start < len(edits) && edits[start].op == ' ' {
+			start++
+		}
+		if start == len(edits) {
+			break
+		}
+		hunkStart := max(start-diffContextLines, 0)
+
+		// Extend the hunk until a run of unchanged lines is long enough to split on.
+		end := start
+		for end < len(edits) {
+			if edits[end].op != ' ' {
+				end++
+				continue
+			}
+			run := end
+			for run < len(edits) && edits[run].op == ' ' {
+				run++
+			}
+			if run == len(edits) || run-end > 2*diffContextLines {
+				end = min(end+diffContextLines, len(edits))
+				break
+			}
+			end = run
+		}
+
+		writeHunk(&out, edits[hunkStart:end])
+		start = end
+	}
+	return out.String()
+}
+
+type lineEdit struct {
+	op      byte
+	text    string
+	oldLine int
+	newLine int
+}
+
+func writeHunk(out *strings.Builder, edits []lineEdit) {
+	oldStart, newStart := 0, 0
+	oldCount, newCount := 0, 0
+	for _, edit := range edits {
+		if edit.op != '+' {
+			if oldCount == 0 {
+				oldStart = edit.oldLine
+			}
+			oldCount++
+		}
+		if edit.op != '-' {
+			if newCount == 0 {
+				newStart = edit.newLine
+			}
+			newCount++
+		}
+	}
+	// Empty ranges point at the line before the hunk, matching diff -u.
+	if oldCount == 0 {
+		oldStart = edits[0].oldLine - 1
+	}
+	if newCount == 0 {
+		newStart = edits[0].newLine - 1
+	}
+
+	fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+	for _, edit := range edits {
+		out.WriteByte(edit.op)
+		out.WriteString(edit.text)
+		if !strings.HasSuffix(edit.text, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+func hunkRange(start, count int) string {
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// lineEdits builds an edit script with 1-based line numbers from an LCS alignment.
+func lineEdits(oldLines, newLines []string) []lineEdit {
+	matches := lineMatches(oldLines, newLines)
+	edits := make([]lineEdit, 0, len(oldLines)+len(newLines))
+
+	i, j := 0, 0
+	for _, match := range append(matches, [2]int{len(oldLines), len(newLines)}) {
+		for ; i < match[0]; i++ {
+			edits = append(edits, lineEdit{op: '-', text: oldLines[i], oldLine: i + 1, newLine: j + 1})
+		}
+		for ; j < match[1]; j++ {
+			edits = append(edits, lineEdit{op: '+', text: newLines[j], oldLine: i + 1, newLine: j + 1})
+		}
+		if i < len(oldLines) && j < len(newLines) {
+			edits = append(edits, lineEdit{op: ' ', text: oldLines[i], oldLine: i + 1, newLine: j + 1})
+			i++
+			j++
+		}
+	}
+	return edits
+}
+
+// lineMatches returns index pairs of a longest common subsequence of a and b.
+func lineMatches(a, b []string) [][2]int {
+	// Trim a shared prefix and suffix first; most edits are small and local.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	matches := make([][2]int, 0, prefix+suffix)
+	for i := 0; i < prefix; i++ {
+		matches = append(matches, [2]int{i, i})
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+	if len(midA) > 0 && len(midB) > 0 && (len(midA)+1)*(len(midB)+1) <= maxDiffCells {
+		width := len(midB) + 1
+		table := make([]int, (len(midA)+1)*width)
+		for i := len(midA) - 1; i >= 0; i-- {
+			for j := len(midB) - 1; j >= 0; j-- {
+				if midA[i] == midB[j] {
+					table[i*width+j] = table[(i+1)*width+j+1] + 1
+				} else {
+					table[i*width+j] = max(table[(i+1)*width+j], table[i*width+j+1])
+				}
+			}
+		}
+		for i, j := 0, 0; i < len(midA) && j < len(midB); {
+			switch {
+			case midA[i] == midB[j]:
+				matches = append(matches, [2]int{prefix + i, prefix + j})
+				i++
+				j++
+			case table[(i+1)*width+j] >= table[i*width+j+1]:
+				i++
+			default:
+				j++
+			}
+		}
+	}
+
+	for k := suffix; k > 0; k-- {
+		matches = append(matches, [2]int{len(a) - k, len(b) - k})
+	}
+	return matches
+}
+
+// splitLines splits text into lines that keep their trailing newline.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func isText(contents []byte) bool {
+	return utf8.Valid(contents) && !bytes.ContainsRune(contents, 0)
+}
+
+func renderTreeFile(file treeFile) []byte {
+	if file.symlink {
+		return []byte("symlink -> " + string(file.contents) + "\n")
+	}
+	return file.contents
+}
+
+// readTreeFiles loads regular files and symlink targets under dir keyed by slash path.
+// A missing dir is treated as an empty tree.
+func readTreeFiles(dir string) (map[string]treeFile, error) {
+	files := map[string]treeFile{}
+	if dir == "" {
+		return files, nil
+	}
+
+	dirAbs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	err = filepath.WalkDir(dirAbs, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dirAbs, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.Type()&os.ModeSymlink != 0 {
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			files[rel] = treeFile{contents: []byte(target), symlink: true}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return fmt.Errorf("unsupported file type %q", path)
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[rel] = treeFile{contents: contents, mode: info.Mode().Perm()}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return map[string]treeFile{}, nil
+		}
+		return nil, err
+	}
+	return files, nil
+}
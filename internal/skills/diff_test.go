@@ -0,0 +1,99 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiffRendersHunks(t *testing.T) {
+	oldText := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n"
+	newText := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nL\nm\n"
+
+	got := UnifiedDiff("store/go/SKILL.md", "project/go/SKILL.md", oldText, newText)
+	want := strings.Join([]string{
+		"--- store/go/SKILL.md",
+		"+++ project/go/SKILL.md",
+		"@@ -1,5 +1,5 @@",
+		" a",
+		"-b",
+		"+B",
+		" c",
+		" d",
+		" e",
+		"@@ -9,4 +9,5 @@",
+		" i",
+		" j",
+		" k",
+		"-l",
+		"+L",
+		"+m",
+		"",
+	}, "\n")
+	if got != want {
+		t.Fatalf("UnifiedDiff() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestUnifiedDiffMarksMissingTrailingNewline(t *testing.T) {
+	got := UnifiedDiff("a", "b", "x\n", "x")
+	want := "--- a\n+++ b\n@@ -1 +1 @@\n-x\n+x\n\\ No newline at end of file\n"
+	if got != want {
+		t.Fatalf("UnifiedDiff() = %q, want %q", got, want)
+	}
+}
+
+func TestUnifiedDiffEqualInputsReturnsEmpty(t *testing.T) {
+	if got := UnifiedDiff("a", "b", "same\n", "same\n"); got != "" {
+		t.Fatalf("UnifiedDiff() = %q, want empty", got)
+	}
+}
+
+func TestDiffTreesReportsAddedRemovedAndModified(t *testing.T) {
+	tmp := t.TempDir()
+	storeDir := filepath.Join(tmp, "store", "go")
+	projectDir := filepath.Join(tmp, "project", "go")
+
+	mustMkdirAll(t, storeDir)
+	mustMkdirAll(t, projectDir)
+	mustWriteFile(t, filepath.Join(storeDir, "SKILL.md"), "---\nname: go\n---\nold body\n")
+	mustWriteFile(t, filepath.Join(projectDir, "SKILL.md"), "---\nname: go\n---\nnew body\n")
+	mustWriteFile(t, filepath.Join(storeDir, "removed.txt"), "gone\n")
+	mustWriteFile(t, filepath.Join(projectDir, "added.txt"), "fresh\n")
+	mustWriteFile(t, filepath.Join(storeDir, "same.txt"), "same\n")
+	mustWriteFile(t, filepath.Join(projectDir, "same.txt"), "same\n")
+	if err := os.WriteFile(filepath.Join(projectDir, "logo.bin"), []byte{0, 1, 2}, 0o644); err != nil {
+		t.Fatalf("WriteFile(logo.bin) error = %v", err)
+	}
+
+	changes, err := DiffTrees(storeDir, projectDir, "store/go", "project/go")
+	if err != nil {
+		t.Fatalf("DiffTrees() error = %v", err)
+	}
+
+	if len(changes) != 4 {
+		t.Fatalf("len(changes) = %d, want 4: %+v", len(changes), changes)
+	}
+	wantKinds := map[string]FileChangeKind{
+		"SKILL.md":    FileModified,
+		"added.txt":   FileAdded,
+		"logo.bin":    FileAdded,
+		"removed.txt": FileRemoved,
+	}
+	for _, change := range changes {
+		if want, ok := wantKinds[change.Path]; !ok || change.Kind != want {
+			t.Fatalf("change %q kind = %q, want %q", change.Path, change.Kind, want)
+		}
+	}
+
+	if changes[0].Path != "SKILL.md" || !strings.Contains(changes[0].Diff, "-old body\n+new body\n") {
+		t.Fatalf("SKILL.md diff = %q, want body change", changes[0].Diff)
+	}
+	if changes[1].Path != "added.txt" || !strings.HasPrefix(changes[1].Diff, "--- /dev/null\n+++ project/go/added.txt\n") {
+		t.Fatalf("added.txt diff = %q, want /dev/null header", changes[1].Diff)
+	}
+	if !changes[2].Binary || changes[2].Diff != "" {
+		t.Fatalf("logo.bin change = %+v, want binary without diff", changes[2])
+	}
+}
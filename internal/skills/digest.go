@@ -0,0 +1,91 @@
+package skills
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+const digestPrefix = "sha256:"
+
+// DigestDir returns a content digest of the tree rooted at dir.
+// The digest covers relative paths, entry types, executable bits, file
+// contents, and symlink targets, so it is stable across copies and hosts.
+func DigestDir(dir string) (string, error) {
+	dirAbs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Stat(dirAbs)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%q is not a directory", dirAbs)
+	}
+
+	hash := sha256.New()
+	// WalkDir visits entries in lexical order, which keeps the digest deterministic.
+	err = filepath.WalkDir(dirAbs, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		rel, err := filepath.Rel(dirAbs, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		mode := info.Mode()
+		switch {
+		case mode.IsDir():
+			fmt.Fprintf(hash, "dir %s\n", rel)
+		case mode.Type()&os.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(hash, "symlink %s %s\n", rel, target)
+		case mode.IsRegular():
+			fmt.Fprintf(hash, "file %s %t %d\n", rel, mode.Perm()&0o111 != 0, info.Size())
+			if err := hashFile(hash, path); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported file type %q", path)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return digestPrefix + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func hashFile(w io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	_, copyErr := io.Copy(w, file)
+	closeErr := file.Close()
+	if copyErr != nil {
+		return copyErr
+	}
+	return closeErr
+}
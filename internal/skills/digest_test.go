@@ -0,0 +1,70 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDigestDirIsStableAcrossCopies(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "store", "go")
+	dest := filepath.Join(tmp, "project", "go")
+
+	mustMkdirAll(t, filepath.Join(source, "templates"))
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "go-skill")
+	mustWriteFile(t, filepath.Join(source, "templates", "snippet.txt"), "hello")
+	mustMkdirAll(t, filepath.Dir(dest))
+
+	if _, err := Copy(source, dest); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+
+	sourceDigest, err := DigestDir(source)
+	if err != nil {
+		t.Fatalf("DigestDir(source) error = %v", err)
+	}
+	destDigest, err := DigestDir(dest)
+	if err != nil {
+		t.Fatalf("DigestDir(dest) error = %v", err)
+	}
+	if !strings.HasPrefix(sourceDigest, "sha256:") {
+		t.Fatalf("DigestDir() = %q, want sha256 prefix", sourceDigest)
+	}
+	if sourceDigest != destDigest {
+		t.Fatalf("digests differ: source %q, dest %q", sourceDigest, destDigest)
+	}
+}
+
+func TestDigestDirChangesWithContentsAndNames(t *testing.T) {
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, "go")
+	mustMkdirAll(t, dir)
+	mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "one")
+
+	first, err := DigestDir(dir)
+	if err != nil {
+		t.Fatalf("DigestDir() error = %v", err)
+	}
+
+	mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "two")
+	second, err := DigestDir(dir)
+	if err != nil {
+		t.Fatalf("DigestDir() error = %v", err)
+	}
+	if first == second {
+		t.Fatal("digest unchanged after content edit")
+	}
+
+	if err := os.Rename(filepath.Join(dir, "SKILL.md"), filepath.Join(dir, "OTHER.md")); err != nil {
+		t.Fatalf("Rename() error = %v", err)
+	}
+	third, err := DigestDir(dir)
+	if err != nil {
+		t.Fatalf("DigestDir() error = %v", err)
+	}
+	if second == third {
+		t.Fatal("digest unchanged after rename")
+	}
+}
@@ -6,16 +6,22 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 )
 
 // Skill describes a store-available skill directory entry.
 type Skill struct {
-	Name string
-	Path string
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// Store names the store the skill was discovered in, when known.
+	Store string `json:"store,omitempty"`
+	// Meta is the parsed SKILL.md frontmatter, empty when it cannot be parsed.
+	Meta Metadata `json:"metadata"`
 }
 
 // Discover returns all valid skill directories in sourceDir sorted by name.
-// A skill is valid only when it is a directory containing SKILL.md.
+// A skill is valid only when it is a directory containing SKILL.md. Hidden
+// directories such as backups and in-progress copies are skipped.
 func Discover(sourceDir string) ([]Skill, error) {
 	sourceAbs, err := filepath.Abs(sourceDir)
 	if err != nil {
@@ -31,6 +37,9 @@ func Discover(sourceDir string) ([]Skill, error) {
 			}
 			return walkErr
 		}
+		if d.IsDir() && isHiddenSubdir(path, sourceAbs) {
+			return filepath.SkipDir
+		}
 		if d.IsDir() || d.Name() != "SKILL.md" {
 			return nil
 		}
@@ -45,7 +54,7 @@ func Discover(sourceDir string) ([]Skill, error) {
 			return fmt.Errorf("duplicate skill %q found in %q and %q", name, previous, skillDir)
 		}
 		seen[name] = skillDir
-		skills = append(skills, Skill{Name: name, Path: skillDir})
+		skills = append(skills, Skill{Name: name, Path: skillDir, Meta: ReadMetadata(skillDir)})
 		return nil
 	})
 	if err != nil {
@@ -58,3 +67,8 @@ func Discover(sourceDir string) ([]Skill, error) {
 	sort.Slice(skills, func(i, j int) bool { return skills[i].Name < skills[j].Name })
 	return skills, nil
 }
+
+// isHiddenSubdir reports whether path is a dot-directory below root.
+func isHiddenSubdir(path, root string) bool {
+	return filepath.Clean(path) != filepath.Clean(root) && strings.HasPrefix(filepath.Base(path), ".")
+}
@@ -1,7 +1,6 @@
 package skills
 
 import (
-	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -74,16 +73,22 @@ func TestDiscoverDuplicateSkillNamesReturnsError(t *testing.T) {
 	}
 }
 
-func mustMkdirAll(t *testing.T, path string) {
-	t.Helper()
-	if err := os.MkdirAll(path, 0o755); err != nil {
-		t.Fatalf("MkdirAll(%q) error = %v", path, err)
-	}
-}
+func TestDiscoverSkipsHiddenDirectories(t *testing.T) {
+	tmp := t.TempDir()
+	sourceDir := filepath.Join(tmp, "global")
 
-func mustWriteFile(t *testing.T, path, contents string) {
-	t.Helper()
-	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
-		t.Fatalf("WriteFile(%q) error = %v", path, err)
+	mustMkdirAll(t, filepath.Join(sourceDir, "go"))
+	mustMkdirAll(t, filepath.Join(sourceDir, ".bond", "backups", "go"))
+	mustMkdirAll(t, filepath.Join(sourceDir, ".react.tmp-123"))
+	mustWriteFile(t, filepath.Join(sourceDir, "go", "SKILL.md"), "go")
+	mustWriteFile(t, filepath.Join(sourceDir, ".bond", "backups", "go", "SKILL.md"), "go")
+	mustWriteFile(t, filepath.Join(sourceDir, ".react.tmp-123", "SKILL.md"), "react")
+
+	got, err := Discover(sourceDir)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "go" {
+		t.Fatalf("Discover() = %+v, want only go", got)
 	}
 }
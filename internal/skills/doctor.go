@@ -0,0 +1,362 @@
+package skills
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FindingKind names one kind of problem bond doctor reports.
+type FindingKind string
+
+const (
+	FindingStoreMissing      FindingKind = "store_missing"
+	FindingStoreInaccessible FindingKind = "store_inaccessible"
+	FindingDuplicateSkill    FindingKind = "duplicate_skill"
+	FindingBrokenLink        FindingKind = "broken_link"
+	FindingExternalLink      FindingKind = "external_link"
+	FindingJunk              FindingKind = "junk"
+	FindingInterruptedCopy   FindingKind = "interrupted_copy"
+	FindingInvalidSkill      FindingKind = "invalid_skill"
+)
+
+// FindingSeverity says whether a finding breaks bond or only deserves attention.
+type FindingSeverity string
+
+const (
+	SeverityError   FindingSeverity = "error"
+	SeverityWarning FindingSeverity = "warning"
+)
+
+// Finding is one problem found by a health check.
+type Finding struct {
+	Kind     FindingKind     `json:"kind"`
+	Severity FindingSeverity `json:"severity"`
+	Path     string          `json:"path"`
+	Message  string          `json:"message"`
+	// Repair lists the operations that fix the problem, empty when it needs a person.
+	Repair []Operation `json:"repair,omitempty"`
+	// Hint suggests a manual fix when there is no repair.
+	Hint string `json:"hint,omitempty"`
+}
+
+// CheckStores reports missing or inaccessible stores, duplicate skill names
+// within a store, leftovers of interrupted copies, and invalid store skills.
+func CheckStores(stores []StoreDir) ([]Finding, error) {
+	findings := []Finding{}
+	for _, store := range stores {
+		info, err := os.Stat(store.Path)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				return nil, err
+			}
+			findings = append(findings, Finding{
+				Kind:     FindingStoreMissing,
+				Severity: SeverityError,
+				Path:     store.Path,
+				Message:  fmt.Sprintf("store %s does not exist", storeLabel(store)),
+				Hint:     "run bond init --store or fix the stores setting",
+			})
+			continue
+		}
+		if !info.IsDir() {
+			findings = append(findings, Finding{
+				Kind:     FindingStoreInaccessible,
+				Severity: SeverityError,
+				Path:     store.Path,
+				Message:  fmt.Sprintf("store %s is not a directory", storeLabel(store)),
+			})
+			continue
+		}
+		if _, err := os.ReadDir(store.Path); err != nil {
+			findings = append(findings, Finding{
+				Kind:     FindingStoreInaccessible,
+				Severity: SeverityError,
+				Path:     store.Path,
+				Message:  fmt.Sprintf("store %s cannot be read: %v", storeLabel(store), err),
+			})
+			continue
+		}
+		if info.Mode().Perm()&0o200 == 0 {
+			findings = append(findings, Finding{
+				Kind:     FindingStoreInaccessible,
+				Severity: SeverityWarning,
+				Path:     store.Path,
+				Message:  fmt.Sprintf("store %s is not writable, so create and store cannot add skills to it", storeLabel(store)),
+			})
+		}
+
+		duplicates, err := findDuplicateSkills(store.Path)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, duplicates...)
+
+		leftovers, err := FindInterruptedCopies(store.Path)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, leftovers...)
+
+		// Discover refuses stores with duplicate names, which were reported above.
+		if len(duplicates) > 0 {
+			continue
+		}
+		discovered, err := Discover(store.Path)
+		if err != nil {
+			return nil, err
+		}
+		for _, skill := range discovered {
+			invalid, err := checkSkillValid(skill.Name, skill.Path)
+			if err != nil {
+				return nil, err
+			}
+			findings = append(findings, invalid...)
+		}
+	}
+	return findings, nil
+}
+
+// CheckProject reports broken and external links, entries that are not
+// skills, leftovers of interrupted copies, and invalid skills in
+// projectSkillsDir. Broken links are repaired by relinking to the visible
+// store skill of the same name, or by removing them when there is none.
+func CheckProject(stores []StoreDir, projectSkillsDir string) ([]Finding, error) {
+	report, err := InspectStatusStores(stores, projectSkillsDir)
+	if err != nil {
+		return nil, err
+	}
+	// A store that cannot be discovered is reported by CheckStores. Until it
+	// is fixed, broken links get no repair, since relinking needs discovery.
+	var byName map[string]Skill
+	if visible, _, err := DiscoverStores(stores); err == nil {
+		byName = make(map[string]Skill, len(visible))
+		for _, skill := range visible {
+			byName[skill.Name] = skill
+		}
+	}
+
+	findings := []Finding{}
+	for _, entry := range report.Entries {
+		switch entry.Status {
+		case StatusBroken:
+			findings = append(findings, brokenLinkFinding(entry, byName))
+		case StatusExternal:
+			target, _ := os.Readlink(entry.Path)
+			findings = append(findings, Finding{
+				Kind:     FindingExternalLink,
+				Severity: SeverityWarning,
+				Path:     entry.Path,
+				Message:  fmt.Sprintf("%s links outside the stores to %s", entry.Name, target),
+				Hint:     "store it with bond store or link it from a store",
+			})
+		case StatusLinked:
+		default:
+			finding, ok, err := checkProjectEntry(entry)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				findings = append(findings, finding)
+			}
+		}
+	}
+	return findings, nil
+}
+
+// FindInterruptedCopies reports hidden .<name>.tmp-* and .<name>.old-*
+// directories that an interrupted copy or replace left in dir, with a repair
+// that removes them or, when <name> is gone, moves the old tree back.
+func FindInterruptedCopies(dir string) ([]Finding, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []Finding{}, nil
+		}
+		return nil, err
+	}
+
+	findings := []Finding{}
+	for _, entry := range entries {
+		if !isInterruptedCopy(entry.Name()) {
+			continue
+		}
+		finding, err := interruptedCopyFinding(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding)
+	}
+	return findings, nil
+}
+
+// interruptedCopyFinding reports the leftover at path. A .<name>.old-* tree
+// whose <name> is gone is the only copy of that skill, since swapIntoPlace
+// moves the original aside before moving the new tree in, so it is moved
+// back rather than deleted.
+func interruptedCopyFinding(path string) (Finding, error) {
+	finding := Finding{
+		Kind:     FindingInterruptedCopy,
+		Severity: SeverityWarning,
+		Path:     path,
+		Message:  fmt.Sprintf("%s was left behind by an interrupted copy", path),
+		Repair:   []Operation{{Kind: OpRemoveTree, Path: path}},
+	}
+
+	name := filepath.Base(path)
+	cut := strings.LastIndex(name, ".old-")
+	if cut < 1 {
+		return finding, nil
+	}
+	original := filepath.Join(filepath.Dir(path), name[1:cut])
+	if _, err := os.Lstat(original); err == nil {
+		return finding, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return Finding{}, err
+	}
+	finding.Message = fmt.Sprintf("%s holds %s, which an interrupted copy moved aside", path, filepath.Base(original))
+	finding.Repair = []Operation{{Kind: OpMoveTree, Path: original, Source: path}}
+	return finding, nil
+}
+
+// isInterruptedCopy matches the temp names used by stageCopy and swapIntoPlace.
+func isInterruptedCopy(name string) bool {
+	return strings.HasPrefix(name, ".") && (strings.Contains(name, ".tmp-") || strings.Contains(name, ".old-"))
+}
+
+// brokenLinkFinding reports a dangling link and how to repair it. byName is
+// nil when the stores could not be discovered.
+func brokenLinkFinding(entry StatusEntry, byName map[string]Skill) Finding {
+	target, _ := os.Readlink(entry.Path)
+	finding := Finding{
+		Kind:     FindingBrokenLink,
+		Severity: SeverityError,
+		Path:     entry.Path,
+		Message:  fmt.Sprintf("%s links to missing %s", entry.Name, target),
+	}
+	if byName == nil {
+		finding.Hint = "fix the store problems first"
+		return finding
+	}
+
+	remove := Operation{Kind: OpRemoveLink, Path: entry.Path, Source: target}
+	if skill, ok := byName[entry.Name]; ok {
+		finding.Repair = []Operation{remove, {Kind: OpSymlink, Path: entry.Path, Source: skill.Path}}
+	} else {
+		finding.Repair = []Operation{remove}
+	}
+	return finding
+}
+
+// checkProjectEntry inspects a project entry that is not a symlink. ok is
+// false for healthy skill directories and files bond writes there itself.
+func checkProjectEntry(entry StatusEntry) (Finding, bool, error) {
+	if isInterruptedCopy(entry.Name) {
+		finding, err := interruptedCopyFinding(entry.Path)
+		return finding, err == nil, err
+	}
+	if IsIndexFile(entry.Name) {
+		return Finding{}, false, nil
+	}
+
+	info, err := os.Stat(entry.Path)
+	if err != nil {
+		return Finding{}, false, err
+	}
+	if info.IsDir() {
+		if _, err := os.Stat(filepath.Join(entry.Path, "SKILL.md")); err == nil {
+			invalid, err := checkSkillValid(entry.Name, entry.Path)
+			if err != nil || len(invalid) == 0 {
+				return Finding{}, false, err
+			}
+			return invalid[0], true, nil
+		}
+	}
+	return Finding{
+		Kind:     FindingJunk,
+		Severity: SeverityWarning,
+		Path:     entry.Path,
+		Message:  fmt.Sprintf("%s is not a skill", entry.Name),
+		Hint:     "move it out of the skills directory",
+	}, true, nil
+}
+
+// checkSkillValid reports a skill with validation issues as one finding.
+func checkSkillValid(name, path string) ([]Finding, error) {
+	result, err := ValidateSkillDir(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Issues) == 0 {
+		return nil, nil
+	}
+
+	rules := make([]string, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		rules = append(rules, issue.Rule)
+	}
+	return []Finding{{
+		Kind:     FindingInvalidSkill,
+		Severity: SeverityError,
+		Path:     path,
+		Message:  fmt.Sprintf("%s has an invalid SKILL.md (%s)", name, strings.Join(rules, ", ")),
+		Hint:     "run bond validate for details",
+	}}, nil
+}
+
+// findDuplicateSkills reports skill names that appear more than once in a store tree.
+func findDuplicateSkills(storeDir string) ([]Finding, error) {
+	paths := map[string][]string{}
+	err := filepath.WalkDir(storeDir, func(path string, d os.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if d.IsDir() && isHiddenSubdir(path, storeDir) {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || d.Name() != "SKILL.md" {
+			return nil
+		}
+		skillDir := filepath.Dir(path)
+		if filepath.Clean(skillDir) == filepath.Clean(storeDir) {
+			return nil
+		}
+		name := filepath.Base(skillDir)
+		paths[name] = append(paths[name], skillDir)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(paths))
+	for name, dirs := range paths {
+		if len(dirs) > 1 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	findings := make([]Finding, 0, len(names))
+	for _, name := range names {
+		findings = append(findings, Finding{
+			Kind:     FindingDuplicateSkill,
+			Severity: SeverityError,
+			Path:     storeDir,
+			Message:  fmt.Sprintf("skill %s appears more than once: %s", name, strings.Join(paths[name], ", ")),
+			Hint:     "rename or remove all but one",
+		})
+	}
+	return findings, nil
+}
+
+// storeLabel names store in messages.
+func storeLabel(store StoreDir) string {
+	if store.Name == "" {
+		return store.Path
+	}
+	return fmt.Sprintf("%s (%s)", store.Name, store.Path)
+}
@@ -0,0 +1,132 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCheckStoresReportsMissingDuplicateAndInvalid(t *testing.T) {
+	tmp := t.TempDir()
+	store := filepath.Join(tmp, "store")
+	mustMkdirAll(t, filepath.Join(store, "a", "go"))
+	mustMkdirAll(t, filepath.Join(store, "b", "go"))
+	mustWriteFile(t, filepath.Join(store, "a", "go", "SKILL.md"), "---\nname: go\ndescription: Go\n---\n")
+	mustWriteFile(t, filepath.Join(store, "b", "go", "SKILL.md"), "---\nname: go\ndescription: Go\n---\n")
+	other := filepath.Join(tmp, "other")
+	mustMkdirAll(t, filepath.Join(other, "rust"))
+	mustMkdirAll(t, filepath.Join(other, ".rust.tmp-123"))
+	mustWriteFile(t, filepath.Join(other, "rust", "SKILL.md"), "no frontmatter")
+
+	findings, err := CheckStores([]StoreDir{
+		{Name: "missing", Path: filepath.Join(tmp, "missing")},
+		{Name: "dup", Path: store},
+		{Name: "other", Path: other},
+	})
+	if err != nil {
+		t.Fatalf("CheckStores() error = %v", err)
+	}
+
+	got := findingKinds(findings)
+	want := []FindingKind{FindingStoreMissing, FindingDuplicateSkill, FindingInterruptedCopy, FindingInvalidSkill}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("kinds = %v, want %v", got, want)
+	}
+	if repair := findings[2].Repair; len(repair) != 1 || repair[0].Kind != OpRemoveTree {
+		t.Fatalf("interrupted copy repair = %+v, want remove_tree", repair)
+	}
+}
+
+func TestCheckProjectClassifiesEntriesAndPlansRepairs(t *testing.T) {
+	tmp := t.TempDir()
+	store := filepath.Join(tmp, "store")
+	project := filepath.Join(tmp, "project")
+	mustMkdirAll(t, filepath.Join(store, "go"))
+	mustWriteFile(t, filepath.Join(store, "go", "SKILL.md"), "---\nname: go\ndescription: Go\n---\n")
+	mustMkdirAll(t, filepath.Join(project, "local"))
+	mustWriteFile(t, filepath.Join(project, "local", "SKILL.md"), "---\nname: local\ndescription: Local\n---\n")
+	mustMkdirAll(t, filepath.Join(project, ".local.tmp-1"))
+	mustWriteFile(t, filepath.Join(project, "notes.txt"), "junk")
+	mustWriteFile(t, filepath.Join(project, "INDEX.md"), "index")
+	external := filepath.Join(tmp, "elsewhere")
+	mustMkdirAll(t, external)
+
+	for name, target := range map[string]string{
+		"go":       filepath.Join(tmp, "old-store", "go"),
+		"gone":     filepath.Join(tmp, "old-store", "gone"),
+		"external": external,
+	} {
+		if err := os.Symlink(target, filepath.Join(project, name)); err != nil {
+			t.Fatalf("Symlink(%s) error = %v", name, err)
+		}
+	}
+
+	findings, err := CheckProject([]StoreDir{{Path: store}}, project)
+	if err != nil {
+		t.Fatalf("CheckProject() error = %v", err)
+	}
+
+	byPath := map[string]Finding{}
+	for _, finding := range findings {
+		byPath[filepath.Base(finding.Path)] = finding
+	}
+	if len(findings) != 5 {
+		t.Fatalf("findings = %+v, want 5", findings)
+	}
+
+	relink := byPath["go"].Repair
+	if byPath["go"].Kind != FindingBrokenLink || len(relink) != 2 || relink[1].Kind != OpSymlink || relink[1].Source != filepath.Join(store, "go") {
+		t.Fatalf("go finding = %+v, want relink to store", byPath["go"])
+	}
+	if remove := byPath["gone"].Repair; len(remove) != 1 || remove[0].Kind != OpRemoveLink {
+		t.Fatalf("gone finding = %+v, want link removal", byPath["gone"])
+	}
+	if byPath["external"].Kind != FindingExternalLink || len(byPath["external"].Repair) != 0 {
+		t.Fatalf("external finding = %+v", byPath["external"])
+	}
+	if byPath["notes.txt"].Kind != FindingJunk {
+		t.Fatalf("notes.txt finding = %+v, want junk", byPath["notes.txt"])
+	}
+	if byPath[".local.tmp-1"].Kind != FindingInterruptedCopy {
+		t.Fatalf("temp finding = %+v, want interrupted copy", byPath[".local.tmp-1"])
+	}
+}
+
+func TestFindInterruptedCopiesMovesBackOldTreeWhoseSkillIsGone(t *testing.T) {
+	dir := t.TempDir()
+	mustMkdirAll(t, filepath.Join(dir, "go"))
+	mustMkdirAll(t, filepath.Join(dir, ".go.old-1"))
+	mustMkdirAll(t, filepath.Join(dir, ".rust.old-2"))
+	mustWriteFile(t, filepath.Join(dir, ".rust.old-2", "SKILL.md"), "rust")
+
+	findings, err := FindInterruptedCopies(dir)
+	if err != nil {
+		t.Fatalf("FindInterruptedCopies() error = %v", err)
+	}
+	if len(findings) != 2 {
+		t.Fatalf("findings = %+v, want 2", findings)
+	}
+	if repair := findings[0].Repair; len(repair) != 1 || repair[0].Kind != OpRemoveTree {
+		t.Fatalf(".go.old-1 repair = %+v, want remove_tree while go exists", repair)
+	}
+	want := []Operation{{Kind: OpMoveTree, Path: filepath.Join(dir, "rust"), Source: filepath.Join(dir, ".rust.old-2")}}
+	if repair := findings[1].Repair; !reflect.DeepEqual(repair, want) {
+		t.Fatalf(".rust.old-2 repair = %+v, want %+v", repair, want)
+	}
+
+	if err := ApplyOperation(findings[1].Repair[0]); err != nil {
+		t.Fatalf("ApplyOperation() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "rust", "SKILL.md")); err != nil {
+		t.Fatalf("Stat(rust) error = %v, want the skill moved back", err)
+	}
+}
+
+func findingKinds(findings []Finding) []FindingKind {
+	kinds := make([]FindingKind, 0, len(findings))
+	for _, finding := range findings {
+		kinds = append(kinds, finding.Kind)
+	}
+	return kinds
+}
@@ -0,0 +1,37 @@
+package skills
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SkillDocument is the parsed content of a skill's SKILL.md.
+type SkillDocument struct {
+	Meta Metadata
+	Body string
+}
+
+// ReadSkillDocument parses the frontmatter and body of SKILL.md in skillDir.
+// A missing name falls back to the directory name.
+func ReadSkillDocument(skillDir string) (SkillDocument, error) {
+	marker := filepath.Join(skillDir, "SKILL.md")
+	raw, err := os.ReadFile(marker)
+	if err != nil {
+		return SkillDocument{}, err
+	}
+
+	frontmatter, body, ok := splitFrontmatter(string(raw))
+	if !ok {
+		return SkillDocument{}, fmt.Errorf("%q has no YAML frontmatter", marker)
+	}
+	meta, _, err := ParseMetadata(frontmatter)
+	if err != nil {
+		return SkillDocument{}, fmt.Errorf("invalid YAML in %q: %w", marker, err)
+	}
+	if meta.Name == "" {
+		meta.Name = filepath.Base(skillDir)
+	}
+	return SkillDocument{Meta: meta, Body: strings.TrimSpace(body)}, nil
+}
@@ -0,0 +1,70 @@
+package skills
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+)
+
+// SkillFile is one entry in a skill directory tree.
+type SkillFile struct {
+	// Path is slash-separated and relative to the skill directory.
+	Path  string `json:"path"`
+	Size  int64  `json:"size"`
+	IsDir bool   `json:"is_dir,omitempty"`
+}
+
+// ListSkillFiles returns the entries below skillDir in lexical order. A
+// symlinked skill directory is listed through its target.
+func ListSkillFiles(skillDir string) ([]SkillFile, error) {
+	root, err := filepath.EvalSymlinks(skillDir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []SkillFile{}
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if path == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		file := SkillFile{Path: filepath.ToSlash(rel), IsDir: d.IsDir()}
+		if !d.IsDir() {
+			file.Size = info.Size()
+		}
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// EstimateTokens approximates the model token count of text at four
+// characters per token, which is close enough for English prose and code.
+func EstimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
+
+// EstimateSkillTokens estimates the token count of SKILL.md in skillDir.
+func EstimateSkillTokens(skillDir string) (int, error) {
+	raw, err := os.ReadFile(filepath.Join(skillDir, "SKILL.md"))
+	if err != nil {
+		return 0, err
+	}
+	return EstimateTokens(string(raw)), nil
+}
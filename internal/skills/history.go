@@ -0,0 +1,91 @@
+package skills
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const historyHeader = "# Generated by bond. Read it with bond history.\n"
+
+// MaxHistoryEntries bounds the history; the oldest entries are dropped first.
+const MaxHistoryEntries = 100
+
+// HistoryEntry records the operations one bond command applied.
+type HistoryEntry struct {
+	ID         int         `yaml:"id" json:"id"`
+	Command    string      `yaml:"command" json:"command"`
+	Time       time.Time   `yaml:"time" json:"time"`
+	Operations []Operation `yaml:"operations" json:"operations"`
+	Undone     bool        `yaml:"undone,omitempty" json:"undone,omitempty"`
+}
+
+// History is the per-project record of applied operations, oldest first.
+type History struct {
+	Entries []HistoryEntry `yaml:"entries"`
+}
+
+// LoadHistory reads the history at path. A missing file yields an empty history.
+func LoadHistory(path string) (History, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return History{}, nil
+		}
+		return History{}, err
+	}
+
+	var history History
+	if err := yaml.Unmarshal(raw, &history); err != nil {
+		return History{}, fmt.Errorf("invalid history %q: %w", path, err)
+	}
+	return history, nil
+}
+
+// SaveHistory writes history to path.
+func SaveHistory(path string, history History) error {
+	raw, err := yaml.Marshal(history)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte(historyHeader), raw...), 0o644)
+}
+
+// Append adds an entry for ops with the next ID and drops entries beyond
+// MaxHistoryEntries.
+func (h *History) Append(command string, at time.Time, ops []Operation) HistoryEntry {
+	id := 1
+	if len(h.Entries) > 0 {
+		id = h.Entries[len(h.Entries)-1].ID + 1
+	}
+
+	entry := HistoryEntry{ID: id, Command: command, Time: at.UTC().Truncate(time.Second), Operations: ops}
+	h.Entries = append(h.Entries, entry)
+	if extra := len(h.Entries) - MaxHistoryEntries; extra > 0 {
+		h.Entries = h.Entries[extra:]
+	}
+	return entry
+}
+
+// Find returns the entry with id.
+func (h *History) Find(id int) (*HistoryEntry, bool) {
+	for i := range h.Entries {
+		if h.Entries[i].ID == id {
+			return &h.Entries[i], true
+		}
+	}
+	return nil, false
+}
+
+// LatestActive returns the newest entry that was not undone.
+func (h *History) LatestActive() (*HistoryEntry, bool) {
+	for i := len(h.Entries) - 1; i >= 0; i-- {
+		if !h.Entries[i].Undone {
+			return &h.Entries[i], true
+		}
+	}
+	return nil, false
+}
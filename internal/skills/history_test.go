@@ -0,0 +1,55 @@
+package skills
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestHistoryAppendAssignsIDsAndDropsOldest(t *testing.T) {
+	history := History{}
+	at := time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)
+	for i := 0; i < MaxHistoryEntries+2; i++ {
+		history.Append("bond link go", at, []Operation{{Kind: OpSymlink, Path: "p", Source: "s"}})
+	}
+
+	if len(history.Entries) != MaxHistoryEntries {
+		t.Fatalf("len(Entries) = %d, want %d", len(history.Entries), MaxHistoryEntries)
+	}
+	if first, last := history.Entries[0].ID, history.Entries[len(history.Entries)-1].ID; first != 3 || last != MaxHistoryEntries+2 {
+		t.Fatalf("IDs = %d..%d, want 3..%d", first, last, MaxHistoryEntries+2)
+	}
+	if _, ok := history.Find(1); ok {
+		t.Fatal("Find(1) ok = true, want dropped entry")
+	}
+}
+
+func TestHistoryRoundTripAndLatestActive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.yaml")
+	at := time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	history := History{}
+	history.Append("bond link go", at, []Operation{{Kind: OpSymlink, Path: "/p/go", Source: "/s/go"}})
+	history.Append("bond unlink go", at, []Operation{{Kind: OpRemoveLink, Path: "/p/go", Source: "/s/go"}})
+	history.Entries[1].Undone = true
+	if err := SaveHistory(path, history); err != nil {
+		t.Fatalf("SaveHistory() error = %v", err)
+	}
+
+	loaded, err := LoadHistory(path)
+	if err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	if len(loaded.Entries) != 2 || !loaded.Entries[0].Time.Equal(at.Truncate(time.Second)) {
+		t.Fatalf("loaded = %+v, want 2 entries at %v", loaded, at)
+	}
+	entry, ok := loaded.LatestActive()
+	if !ok || entry.ID != 1 || entry.Operations[0].Source != "/s/go" {
+		t.Fatalf("LatestActive() = %+v, %v, want entry 1", entry, ok)
+	}
+
+	empty, err := LoadHistory(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err != nil || len(empty.Entries) != 0 {
+		t.Fatalf("LoadHistory(missing) = %+v, %v, want empty", empty, err)
+	}
+}
@@ -0,0 +1,126 @@
+package skills
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// IndexFormat selects how bond index renders the project skill catalog.
+type IndexFormat string
+
+const (
+	// IndexFormatAgentsMD writes a managed block into AGENTS.md.
+	IndexFormatAgentsMD IndexFormat = "agents-md"
+	// IndexFormatMarkdown writes a standalone INDEX.md.
+	IndexFormatMarkdown IndexFormat = "markdown"
+	// IndexFormatJSON writes a standalone index.json.
+	IndexFormatJSON IndexFormat = "json"
+)
+
+// indexBlockID is the managed block id of the index in AGENTS.md.
+const indexBlockID = "index"
+
+// IndexEntry is one skill listed in the project index.
+type IndexEntry struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Path is the slash-separated SKILL.md path relative to the index file.
+	Path string `json:"path"`
+}
+
+// IndexFileName returns the standalone file format is written to in the
+// project skills directory, or "" for formats written elsewhere.
+func IndexFileName(format IndexFormat) string {
+	switch format {
+	case IndexFormatMarkdown:
+		return "INDEX.md"
+	case IndexFormatJSON:
+		return "index.json"
+	default:
+		return ""
+	}
+}
+
+// IsIndexFile reports whether name is a file bond index writes into the
+// project skills directory, which status and doctor leave out.
+func IsIndexFile(name string) bool {
+	return name == IndexFileName(IndexFormatMarkdown) || name == IndexFileName(IndexFormatJSON)
+}
+
+// ParseIndexFormat validates a --format value.
+func ParseIndexFormat(raw string) (IndexFormat, error) {
+	switch format := IndexFormat(raw); format {
+	case IndexFormatAgentsMD, IndexFormatMarkdown, IndexFormatJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid index format %q (want agents-md, markdown, or json)", raw)
+	}
+}
+
+// BuildIndex reads name and description from every project skill in
+// projectSkillsDir. Paths are relative to indexDir, where the index is written.
+// Skills whose SKILL.md cannot be parsed are listed without a description.
+func BuildIndex(projectSkillsDir, indexDir string) ([]IndexEntry, error) {
+	discovered, err := DiscoverProjectAll(projectSkillsDir)
+	if err != nil {
+		return nil, err
+	}
+	indexAbs, err := filepath.Abs(indexDir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]IndexEntry, 0, len(discovered))
+	for _, skill := range discovered {
+		rel, err := filepath.Rel(indexAbs, filepath.Join(skill.Path, "SKILL.md"))
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, IndexEntry{Name: skill.Name, Description: skill.Meta.Description, Path: filepath.ToSlash(rel)})
+	}
+	return entries, nil
+}
+
+// RenderIndex returns the new contents of an index file holding current, the
+// existing contents. Only the managed block changes for agents-md; the other
+// formats own the whole file.
+func RenderIndex(format IndexFormat, entries []IndexEntry, current string) (string, error) {
+	switch format {
+	case IndexFormatAgentsMD:
+		return UpsertManagedBlock(current, indexBlockID, renderIndexList(entries, "## Available skills")), nil
+	case IndexFormatMarkdown:
+		return renderIndexList(entries, "# Skills\n\n<!-- Generated by bond index. Do not edit by hand. -->") + "\n", nil
+	case IndexFormatJSON:
+		if entries == nil {
+			entries = []IndexEntry{}
+		}
+		raw, err := json.MarshalIndent(struct {
+			Skills []IndexEntry `json:"skills"`
+		}{Skills: entries}, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(raw) + "\n", nil
+	default:
+		return "", fmt.Errorf("invalid index format %q", format)
+	}
+}
+
+// renderIndexList renders entries as a Markdown list below heading.
+func renderIndexList(entries []IndexEntry, heading string) string {
+	lines := []string{heading, ""}
+	if len(entries) == 0 {
+		lines = append(lines, "No skills installed.")
+	}
+	for _, entry := range entries {
+		line := fmt.Sprintf("- [%s](%s)", entry.Name, entry.Path)
+		if entry.Description != "" {
+			line += ": " + strings.Join(strings.Fields(entry.Description), " ")
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -18,41 +18,53 @@ const (
 
 // LinkResult wraps the final status for a link operation.
 type LinkResult struct {
-	Status LinkStatus
+	Status LinkStatus `json:"status"`
 }
 
 // Link creates destPath as a symlink to sourcePath if possible.
 func Link(sourcePath, destPath string) (LinkResult, error) {
+	result, ops, err := PlanLink(sourcePath, destPath)
+	if err != nil {
+		return LinkResult{}, err
+	}
+	for _, op := range ops {
+		if err := os.Symlink(op.Source, op.Path); err != nil {
+			return LinkResult{}, err
+		}
+	}
+	return result, nil
+}
+
+// PlanLink reports what Link would do without touching disk. The operations
+// are empty unless the status is linked.
+func PlanLink(sourcePath, destPath string) (LinkResult, []Operation, error) {
 	// Canonicalize the source first so comparisons are stable regardless of cwd.
 	sourceAbs, err := filepath.Abs(sourcePath)
 	if err != nil {
-		return LinkResult{}, err
+		return LinkResult{}, nil, err
 	}
 
 	if _, err := os.Lstat(sourceAbs); err != nil {
-		return LinkResult{}, fmt.Errorf("source missing %q: %w", sourceAbs, err)
+		return LinkResult{}, nil, fmt.Errorf("source missing %q: %w", sourceAbs, err)
 	}
 
 	info, err := os.Lstat(destPath)
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			return LinkResult{}, err
+			return LinkResult{}, nil, err
 		}
 		// Destination does not exist yet; create the link directly.
-		if err := os.Symlink(sourceAbs, destPath); err != nil {
-			return LinkResult{}, err
-		}
-		return LinkResult{Status: LinkStatusLinked}, nil
+		return LinkResult{Status: LinkStatusLinked}, []Operation{{Kind: OpSymlink, Path: destPath, Source: sourceAbs}}, nil
 	}
 
 	// An existing non-symlink entry cannot be overwritten by this command.
 	if info.Mode()&os.ModeSymlink == 0 {
-		return LinkResult{Status: LinkStatusConflict}, nil
+		return LinkResult{Status: LinkStatusConflict}, nil, nil
 	}
 
 	target, err := os.Readlink(destPath)
 	if err != nil {
-		return LinkResult{}, err
+		return LinkResult{}, nil, err
 	}
 	// Resolve relative symlink targets against the symlink's parent directory
 	// before comparing with the requested source path.
@@ -62,13 +74,13 @@ func Link(sourcePath, destPath string) (LinkResult, error) {
 
 	targetAbs, err := filepath.Abs(target)
 	if err != nil {
-		return LinkResult{}, err
+		return LinkResult{}, nil, err
 	}
 
 	if filepath.Clean(targetAbs) == filepath.Clean(sourceAbs) {
 		// Existing link already points to the same target.
-		return LinkResult{Status: LinkStatusAlreadyLinked}, nil
+		return LinkResult{Status: LinkStatusAlreadyLinked}, nil, nil
 	}
 
-	return LinkResult{Status: LinkStatusConflict}, nil
+	return LinkResult{Status: LinkStatusConflict}, nil, nil
 }
@@ -0,0 +1,122 @@
+package skills
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const lockfileHeader = "# Generated by bond. Do not edit by hand.\n"
+
+// LockEntry records where a copied skill came from and what was copied.
+type LockEntry struct {
+	Source   string    `yaml:"source"`
+	Digest   string    `yaml:"digest"`
+	CopiedAt time.Time `yaml:"copied_at"`
+}
+
+// OutputEntry records a file, or a managed block of one, generated by an adapter.
+type OutputEntry struct {
+	Adapter string `yaml:"adapter"`
+	Skill   string `yaml:"skill"`
+	// Path is slash-separated and relative to the project root.
+	Path         string `yaml:"path"`
+	Source       string `yaml:"source"`
+	SourceDigest string `yaml:"source_digest"`
+	Digest       string `yaml:"digest"`
+}
+
+// Lockfile captures copy provenance for project skills, keyed by skill name,
+// and adapter outputs, keyed by OutputKey.
+type Lockfile struct {
+	Skills  map[string]LockEntry   `yaml:"skills"`
+	Outputs map[string]OutputEntry `yaml:"outputs,omitempty"`
+}
+
+// CopyDrift reports which side of a recorded copy changed since it was made.
+type CopyDrift struct {
+	ProjectChanged bool
+	StoreChanged   bool
+}
+
+// LoadLockfile reads the lockfile at path. A missing file yields an empty lockfile.
+func LoadLockfile(path string) (Lockfile, error) {
+	lock := Lockfile{Skills: map[string]LockEntry{}}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return lock, nil
+		}
+		return Lockfile{}, err
+	}
+
+	if err := yaml.Unmarshal(raw, &lock); err != nil {
+		return Lockfile{}, fmt.Errorf("invalid lockfile %q: %w", path, err)
+	}
+	if lock.Skills == nil {
+		lock.Skills = map[string]LockEntry{}
+	}
+	return lock, nil
+}
+
+// SaveLockfile writes lock to path with entries sorted by skill name.
+func SaveLockfile(path string, lock Lockfile) error {
+	raw, err := yaml.Marshal(lock)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte(lockfileHeader), raw...), 0o644)
+}
+
+// RecordCopy stores provenance for a skill copied from sourcePath into destPath.
+func (l *Lockfile) RecordCopy(name, sourcePath, destPath string, copiedAt time.Time) error {
+	sourceAbs, err := filepath.Abs(sourcePath)
+	if err != nil {
+		return err
+	}
+
+	digest, err := DigestDir(destPath)
+	if err != nil {
+		return err
+	}
+
+	if l.Skills == nil {
+		l.Skills = map[string]LockEntry{}
+	}
+	l.Skills[name] = LockEntry{
+		Source:   sourceAbs,
+		Digest:   digest,
+		CopiedAt: copiedAt.UTC().Truncate(time.Second),
+	}
+	return nil
+}
+
+// Drift compares the project copy at projectPath and the recorded store source
+// against the digest captured when the copy was made.
+func (e LockEntry) Drift(projectPath string) (CopyDrift, error) {
+	drift := CopyDrift{}
+
+	projectDigest, err := DigestDir(projectPath)
+	if err != nil {
+		return CopyDrift{}, err
+	}
+	drift.ProjectChanged = projectDigest != e.Digest
+
+	storeDigest, err := DigestDir(e.Source)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return CopyDrift{}, err
+		}
+		// A deleted store source counts as a store-side change.
+		drift.StoreChanged = true
+		return drift, nil
+	}
+	drift.StoreChanged = storeDigest != e.Digest
+
+	return drift, nil
+}
@@ -0,0 +1,73 @@
+package skills
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadLockfileMissingReturnsEmpty(t *testing.T) {
+	lock, err := LoadLockfile(filepath.Join(t.TempDir(), "bond.lock"))
+	if err != nil {
+		t.Fatalf("LoadLockfile() error = %v", err)
+	}
+	if lock.Skills == nil || len(lock.Skills) != 0 {
+		t.Fatalf("lock.Skills = %#v, want empty map", lock.Skills)
+	}
+}
+
+func TestLockfileRoundTripAndDrift(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "store", "go")
+	dest := filepath.Join(tmp, "project", "go")
+	lockPath := filepath.Join(tmp, "project", "bond.lock")
+
+	mustMkdirAll(t, source)
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "go-skill")
+	mustMkdirAll(t, filepath.Dir(dest))
+	if _, err := Copy(source, dest); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+
+	copiedAt := time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)
+	lock := Lockfile{}
+	if err := lock.RecordCopy("go", source, dest, copiedAt); err != nil {
+		t.Fatalf("RecordCopy() error = %v", err)
+	}
+	if err := SaveLockfile(lockPath, lock); err != nil {
+		t.Fatalf("SaveLockfile() error = %v", err)
+	}
+
+	loaded, err := LoadLockfile(lockPath)
+	if err != nil {
+		t.Fatalf("LoadLockfile() error = %v", err)
+	}
+	entry, ok := loaded.Skills["go"]
+	if !ok {
+		t.Fatalf("loaded.Skills = %#v, want go entry", loaded.Skills)
+	}
+	if entry.Source != source {
+		t.Fatalf("entry.Source = %q, want %q", entry.Source, source)
+	}
+	if !entry.CopiedAt.Equal(copiedAt.Truncate(time.Second)) {
+		t.Fatalf("entry.CopiedAt = %v, want %v", entry.CopiedAt, copiedAt.Truncate(time.Second))
+	}
+
+	drift, err := entry.Drift(dest)
+	if err != nil {
+		t.Fatalf("Drift() error = %v", err)
+	}
+	if drift.ProjectChanged || drift.StoreChanged {
+		t.Fatalf("Drift() = %+v, want no changes", drift)
+	}
+
+	mustWriteFile(t, filepath.Join(dest, "SKILL.md"), "edited in project")
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "edited in store")
+	drift, err = entry.Drift(dest)
+	if err != nil {
+		t.Fatalf("Drift() error = %v", err)
+	}
+	if !drift.ProjectChanged || !drift.StoreChanged {
+		t.Fatalf("Drift() = %+v, want both sides changed", drift)
+	}
+}
@@ -0,0 +1,78 @@
+package skills
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Managed blocks are regions of a hand-written file that bond owns. Each block
+// sits between begin and end marker comments carrying the same id.
+const (
+	managedBlockBegin = "<!-- bond:begin %s -->"
+	managedBlockEnd   = "<!-- bond:end %s -->"
+)
+
+// ManagedBlock returns the content between the markers for id.
+func ManagedBlock(contents, id string) (string, bool) {
+	begin, end, ok := findManagedBlock(contents, id)
+	if !ok {
+		return "", false
+	}
+	inner := contents[begin+len(managedBlockMarker(managedBlockBegin, id)) : end]
+	return strings.TrimPrefix(strings.TrimSuffix(inner, "\n"), "\n"), true
+}
+
+// UpsertManagedBlock replaces the block for id with block, or appends a new
+// block when contents has none.
+func UpsertManagedBlock(contents, id, block string) string {
+	wrapped := managedBlockMarker(managedBlockBegin, id) + "\n" + block + "\n" + managedBlockMarker(managedBlockEnd, id)
+
+	begin, end, ok := findManagedBlock(contents, id)
+	if ok {
+		return contents[:begin] + wrapped + contents[end+len(managedBlockMarker(managedBlockEnd, id)):]
+	}
+
+	if contents == "" {
+		return wrapped + "\n"
+	}
+	if !strings.HasSuffix(contents, "\n") {
+		contents += "\n"
+	}
+	return contents + "\n" + wrapped + "\n"
+}
+
+// RemoveManagedBlock deletes the block for id along with its markers.
+// It reports whether a block was found.
+func RemoveManagedBlock(contents, id string) (string, bool) {
+	begin, end, ok := findManagedBlock(contents, id)
+	if !ok {
+		return contents, false
+	}
+
+	before := strings.TrimRight(contents[:begin], "\n")
+	after := strings.TrimLeft(contents[end+len(managedBlockMarker(managedBlockEnd, id)):], "\n")
+	switch {
+	case before == "":
+		return after, true
+	case after == "":
+		return before + "\n", true
+	default:
+		return before + "\n\n" + after, true
+	}
+}
+
+func findManagedBlock(contents, id string) (int, int, bool) {
+	begin := strings.Index(contents, managedBlockMarker(managedBlockBegin, id))
+	if begin < 0 {
+		return 0, 0, false
+	}
+	end := strings.Index(contents[begin:], managedBlockMarker(managedBlockEnd, id))
+	if end < 0 {
+		return 0, 0, false
+	}
+	return begin, begin + end, true
+}
+
+func managedBlockMarker(format, id string) string {
+	return fmt.Sprintf(format, id)
+}
@@ -0,0 +1,26 @@
+package skills
+
+import "testing"
+
+func TestManagedBlockUpsertReplaceAndRemove(t *testing.T) {
+	contents := "# Project\n\nHand-written notes.\n"
+
+	added := UpsertManagedBlock(contents, "skill go", "## go")
+	want := "# Project\n\nHand-written notes.\n\n<!-- bond:begin skill go -->\n## go\n<!-- bond:end skill go -->\n"
+	if added != want {
+		t.Fatalf("UpsertManagedBlock() = %q, want %q", added, want)
+	}
+
+	replaced := UpsertManagedBlock(added, "skill go", "## go v2")
+	if block, ok := ManagedBlock(replaced, "skill go"); !ok || block != "## go v2" {
+		t.Fatalf("ManagedBlock() = %q, %v, want replaced block", block, ok)
+	}
+
+	removed, ok := RemoveManagedBlock(replaced, "skill go")
+	if !ok || removed != contents {
+		t.Fatalf("RemoveManagedBlock() = %q, %v, want %q", removed, ok, contents)
+	}
+	if _, ok := ManagedBlock(removed, "skill go"); ok {
+		t.Fatal("ManagedBlock() found a removed block")
+	}
+}
@@ -0,0 +1,217 @@
+package skills
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestMode describes how a manifest skill is materialized in the project.
+type ManifestMode string
+
+const (
+	ManifestModeLink ManifestMode = "link"
+	ManifestModeCopy ManifestMode = "copy"
+)
+
+// ManifestSkill declares one store skill the project expects.
+type ManifestSkill struct {
+	Name string       `yaml:"name"`
+	Mode ManifestMode `yaml:"mode,omitempty"`
+}
+
+// Manifest is the declarative project skill list stored in bond.yaml.
+type Manifest struct {
+	// Targets lists project-relative directories that receive skills.
+	Targets []string `yaml:"targets,omitempty"`
+	// Adapters names the adapters that render every skill into other formats.
+	Adapters []string        `yaml:"adapters,omitempty"`
+	Skills   []ManifestSkill `yaml:"skills"`
+}
+
+// LoadManifest reads and validates the manifest at path. Entries without a
+// mode use defaultMode, or link when defaultMode is empty.
+// A missing file is returned as an os.ErrNotExist error.
+func LoadManifest(path string, defaultMode ManifestMode) (Manifest, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	manifest := Manifest{}
+	if err := yaml.Unmarshal(raw, &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("invalid manifest %q: %w", path, err)
+	}
+	if err := manifest.normalize(defaultMode); err != nil {
+		return Manifest{}, fmt.Errorf("invalid manifest %q: %w", path, err)
+	}
+	return manifest, nil
+}
+
+// normalize applies default modes and rejects empty, duplicate, or unknown entries.
+func (m *Manifest) normalize(defaultMode ManifestMode) error {
+	switch defaultMode {
+	case "":
+		defaultMode = ManifestModeLink
+	case ManifestModeLink, ManifestModeCopy:
+	default:
+		return fmt.Errorf("invalid default mode %q (want link or copy)", defaultMode)
+	}
+
+	seenTargets := make(map[string]struct{}, len(m.Targets))
+	for i, target := range m.Targets {
+		clean := filepath.ToSlash(filepath.Clean(strings.TrimSpace(target)))
+		if target == "" || filepath.IsAbs(clean) || clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+			return fmt.Errorf("target %q must be a relative path inside the project", target)
+		}
+		if _, exists := seenTargets[clean]; exists {
+			return fmt.Errorf("target %q is listed more than once", target)
+		}
+		seenTargets[clean] = struct{}{}
+		m.Targets[i] = clean
+	}
+
+	seenAdapters := make(map[string]struct{}, len(m.Adapters))
+	for i, name := range m.Adapters {
+		name = strings.TrimSpace(name)
+		if _, ok := LookupAdapter(name); !ok {
+			return fmt.Errorf("unknown adapter %q (want one of %s)", name, strings.Join(AdapterNames(), ", "))
+		}
+		if _, exists := seenAdapters[name]; exists {
+			return fmt.Errorf("adapter %q is listed more than once", name)
+		}
+		seenAdapters[name] = struct{}{}
+		m.Adapters[i] = name
+	}
+
+	seen := make(map[string]struct{}, len(m.Skills))
+	for i := range m.Skills {
+		entry := &m.Skills[i]
+		entry.Name = strings.TrimSpace(entry.Name)
+		if entry.Name == "" {
+			return fmt.Errorf("skill entry %d has an empty name", i+1)
+		}
+		if _, exists := seen[entry.Name]; exists {
+			return fmt.Errorf("skill %q is listed more than once", entry.Name)
+		}
+		seen[entry.Name] = struct{}{}
+
+		switch entry.Mode {
+		case "":
+			entry.Mode = defaultMode
+		case ManifestModeLink, ManifestModeCopy:
+		default:
+			return fmt.Errorf("skill %q has invalid mode %q (want link or copy)", entry.Name, entry.Mode)
+		}
+	}
+	return nil
+}
+
+// RenameManifestSkill renames the skills entry oldName to newName in the
+// manifest at path and reports whether the manifest lists oldName. Only the
+// name itself is rewritten; the rest of the file keeps its bytes. With write
+// false the manifest is only checked, as dry runs need. A missing manifest
+// lists nothing.
+func RenameManifestSkill(path, oldName, newName string, write bool) (bool, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return false, fmt.Errorf("invalid manifest %q: %w", path, err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return false, nil
+	}
+
+	var found *yaml.Node
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value != "skills" || root.Content[i+1].Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, entry := range root.Content[i+1].Content {
+			name := mappingValue(entry, "name")
+			if name == nil {
+				continue
+			}
+			switch strings.TrimSpace(name.Value) {
+			case oldName:
+				found = name
+			case newName:
+				return false, fmt.Errorf("manifest %q already lists %s", path, newName)
+			}
+		}
+	}
+	if found == nil {
+		return false, nil
+	}
+	if !write {
+		return true, nil
+	}
+
+	updated, err := replaceScalar(raw, found, newName)
+	if err != nil {
+		return false, fmt.Errorf("manifest %q: %w", path, err)
+	}
+	return true, os.WriteFile(path, updated, 0o644)
+}
+
+// replaceScalar rewrites the scalar node in raw, the source it was parsed
+// from, to value in the same quoting style. Everything else in raw is kept
+// byte for byte.
+func replaceScalar(raw []byte, node *yaml.Node, value string) ([]byte, error) {
+	start := 0
+	for line := 1; line < node.Line; line++ {
+		next := bytes.IndexByte(raw[start:], '\n')
+		if next < 0 {
+			return nil, fmt.Errorf("cannot find %s on line %d", node.Value, node.Line)
+		}
+		start += next + 1
+	}
+	for column := 1; column < node.Column && start < len(raw); column++ {
+		_, size := utf8.DecodeRune(raw[start:])
+		start += size
+	}
+
+	quote := ""
+	switch node.Style {
+	case yaml.DoubleQuotedStyle:
+		quote = `"`
+	case yaml.SingleQuotedStyle:
+		quote = "'"
+	}
+	old := quote + node.Value + quote
+	if !bytes.HasPrefix(raw[start:], []byte(old)) {
+		return nil, fmt.Errorf("cannot find %s on line %d", node.Value, node.Line)
+	}
+
+	updated := make([]byte, 0, len(raw)-len(old)+len(quote)*2+len(value))
+	updated = append(updated, raw[:start]...)
+	updated = append(updated, quote+value+quote...)
+	return append(updated, raw[start+len(old):]...), nil
+}
+
+// mappingValue returns the value node for key in a mapping node.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
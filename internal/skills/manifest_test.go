@@ -0,0 +1,174 @@
+package skills
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadManifestAppliesDefaultMode(t *testing.T) {
+	tmp := t.TempDir()
+	path := filepath.Join(tmp, "bond.yaml")
+	mustWriteFile(t, path, "skills:\n  - name: go\n  - name: react\n    mode: copy\n")
+
+	manifest, err := LoadManifest(path, "")
+	if err != nil {
+		t.Fatalf("LoadManifest() error = %v", err)
+	}
+	if len(manifest.Skills) != 2 {
+		t.Fatalf("len(manifest.Skills) = %d, want 2", len(manifest.Skills))
+	}
+	if got := manifest.Skills[0]; got.Name != "go" || got.Mode != ManifestModeLink {
+		t.Fatalf("manifest.Skills[0] = %+v, want go/link", got)
+	}
+	if got := manifest.Skills[1]; got.Name != "react" || got.Mode != ManifestModeCopy {
+		t.Fatalf("manifest.Skills[1] = %+v, want react/copy", got)
+	}
+}
+
+func TestLoadManifestRejectsInvalidEntries(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		want     string
+	}{
+		{name: "empty name", contents: "skills:\n  - mode: link\n", want: "empty name"},
+		{name: "duplicate", contents: "skills:\n  - name: go\n  - name: go\n", want: "listed more than once"},
+		{name: "bad mode", contents: "skills:\n  - name: go\n    mode: move\n", want: `invalid mode "move"`},
+		{name: "bad yaml", contents: "skills: [", want: "invalid manifest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "bond.yaml")
+			mustWriteFile(t, path, tt.contents)
+
+			_, err := LoadManifest(path, "")
+			if err == nil {
+				t.Fatal("LoadManifest() error = nil, want non-nil")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("LoadManifest() error = %q, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadManifestReturnsNotExistForMissingFile(t *testing.T) {
+	_, err := LoadManifest(filepath.Join(t.TempDir(), "bond.yaml"), "")
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("LoadManifest() error = %v, want os.ErrNotExist", err)
+	}
+}
+
+func TestLoadManifestUsesConfiguredDefaultMode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bond.yaml")
+	mustWriteFile(t, path, "skills:\n  - name: go\n  - name: react\n    mode: link\n")
+
+	manifest, err := LoadManifest(path, ManifestModeCopy)
+	if err != nil {
+		t.Fatalf("LoadManifest() error = %v", err)
+	}
+	if got := manifest.Skills[0].Mode; got != ManifestModeCopy {
+		t.Fatalf("go mode = %q, want copy", got)
+	}
+	if got := manifest.Skills[1].Mode; got != ManifestModeLink {
+		t.Fatalf("react mode = %q, want explicit link", got)
+	}
+}
+
+func TestLoadManifestNormalizesTargets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bond.yaml")
+	mustWriteFile(t, path, "targets:\n  - .agents/skills\n  - ./.claude/skills/\nskills: []\n")
+
+	manifest, err := LoadManifest(path, "")
+	if err != nil {
+		t.Fatalf("LoadManifest() error = %v", err)
+	}
+	want := []string{".agents/skills", ".claude/skills"}
+	if len(manifest.Targets) != len(want) || manifest.Targets[0] != want[0] || manifest.Targets[1] != want[1] {
+		t.Fatalf("manifest.Targets = %q, want %q", manifest.Targets, want)
+	}
+
+	for _, contents := range []string{
+		"targets:\n  - /abs/skills\n",
+		"targets:\n  - ../outside\n",
+		"targets:\n  - .claude/skills\n  - .claude/skills/\n",
+	} {
+		mustWriteFile(t, path, contents)
+		if _, err := LoadManifest(path, ""); err == nil {
+			t.Fatalf("LoadManifest(%q) error = nil, want non-nil", contents)
+		}
+	}
+}
+
+func TestLoadManifestRejectsUnknownAdapter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bond.yaml")
+	mustWriteFile(t, path, "adapters:\n  - cursor\n  - windsurf\nskills: []\n")
+
+	_, err := LoadManifest(path, "")
+	if err == nil || !strings.Contains(err.Error(), `unknown adapter "windsurf"`) {
+		t.Fatalf("LoadManifest() error = %v, want unknown adapter", err)
+	}
+}
+
+func TestRenameManifestSkillKeepsOtherEntriesAndRejectsTakenNames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bond.yaml")
+	mustWriteFile(t, path, "targets:\n  - .agents/skills\nskills:\n  - name: go\n    mode: copy\n  - name: rust\n")
+
+	listed, err := RenameManifestSkill(path, "go", "golang", false)
+	if err != nil || !listed {
+		t.Fatalf("RenameManifestSkill(check) = %v, %v, want listed", listed, err)
+	}
+	if manifest, _ := LoadManifest(path, ""); manifest.Skills[0].Name != "go" {
+		t.Fatalf("manifest changed without write: %+v", manifest.Skills)
+	}
+
+	if _, err := RenameManifestSkill(path, "go", "golang", true); err != nil {
+		t.Fatalf("RenameManifestSkill() error = %v", err)
+	}
+	manifest, err := LoadManifest(path, "")
+	if err != nil {
+		t.Fatalf("LoadManifest() error = %v", err)
+	}
+	if manifest.Skills[0] != (ManifestSkill{Name: "golang", Mode: ManifestModeCopy}) || manifest.Skills[1].Name != "rust" || len(manifest.Targets) != 1 {
+		t.Fatalf("manifest = %+v, want go renamed only", manifest)
+	}
+
+	if _, err := RenameManifestSkill(path, "golang", "rust", true); err == nil {
+		t.Fatal("RenameManifestSkill(to rust) error = nil, want already listed error")
+	}
+	if listed, err := RenameManifestSkill(filepath.Join(t.TempDir(), "missing.yaml"), "go", "golang", true); err != nil || listed {
+		t.Fatalf("RenameManifestSkill(missing) = %v, %v, want not listed", listed, err)
+	}
+}
+
+func TestRenameManifestSkillRewritesOnlyTheName(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bond.yaml")
+	before := "# skills for this repo\r\ntargets:\r\n  - .agents/skills\r\nskills:\r\n  - name: rust   # systems\r\n  - {name: \"go\", mode: copy}\r\n"
+	mustWriteFile(t, path, before)
+
+	if _, err := RenameManifestSkill(path, "go", "golang", true); err != nil {
+		t.Fatalf("RenameManifestSkill() error = %v", err)
+	}
+	after, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if want := strings.Replace(before, `"go"`, `"golang"`, 1); string(after) != want {
+		t.Fatalf("manifest = %q, want %q", after, want)
+	}
+
+	if _, err := RenameManifestSkill(path, "rust", "rs", true); err != nil {
+		t.Fatalf("RenameManifestSkill(rust) error = %v", err)
+	}
+	renamed, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if want := strings.Replace(string(after), "name: rust ", "name: rs ", 1); string(renamed) != want {
+		t.Fatalf("manifest = %q, want %q", renamed, want)
+	}
+}
@@ -0,0 +1,282 @@
+package skills
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	mergeOursLabel   = "project"
+	mergeTheirsLabel = "store"
+)
+
+// MergeResult describes the outcome of a three-way skill merge.
+type MergeResult struct {
+	// Changed reports whether the store tree was rewritten.
+	Changed bool
+	// Conflicts lists slash-separated paths that could not be merged automatically.
+	Conflicts []string
+}
+
+// Merge three-way merges projectPath into storePath using basePath as the
+// common ancestor. Text files changed on both sides are merged line by line.
+// A clean result is written atomically to storePath. When anything conflicts,
+// the store is left alone and the result goes to projectPath instead, with
+// conflict markers in unresolved hunks and the project version of other
+// conflicting files, so the copy is where conflicts are resolved.
+func Merge(basePath, projectPath, storePath string) (MergeResult, error) {
+	baseFiles, err := readTreeFiles(basePath)
+	if err != nil {
+		return MergeResult{}, err
+	}
+	projectFiles, err := readTreeFiles(projectPath)
+	if err != nil {
+		return MergeResult{}, err
+	}
+	storeFiles, err := readTreeFiles(storePath)
+	if err != nil {
+		return MergeResult{}, err
+	}
+
+	merged, conflicts := mergeTreeFiles(baseFiles, projectFiles, storeFiles)
+	result := MergeResult{Conflicts: conflicts}
+	if len(conflicts) > 0 {
+		return result, writeMergedTree(projectPath, merged)
+	}
+	if treeFilesEqual(merged, storeFiles) {
+		return result, nil
+	}
+	if err := writeMergedTree(storePath, merged); err != nil {
+		return MergeResult{}, err
+	}
+	result.Changed = true
+	return result, nil
+}
+
+// writeMergedTree atomically replaces the tree at path with files.
+func writeMergedTree(path string, files map[string]treeFile) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	tmpDir, err := os.MkdirTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	if err := writeTreeFiles(tmpDir, info.Mode().Perm(), files); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return err
+	}
+	if err := swapIntoPlace(tmpDir, path, ""); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return err
+	}
+	return nil
+}
+
+// mergeTreeFiles merges file maps and returns the merged map with sorted conflict paths.
+func mergeTreeFiles(base, ours, theirs map[string]treeFile) (map[string]treeFile, []string) {
+	paths := map[string]struct{}{}
+	for _, files := range []map[string]treeFile{base, ours, theirs} {
+		for path := range files {
+			paths[path] = struct{}{}
+		}
+	}
+
+	merged := map[string]treeFile{}
+	conflicts := []string{}
+	for path := range paths {
+		baseFile, inBase := base[path]
+		ourFile, inOurs := ours[path]
+		theirFile, inTheirs := theirs[path]
+
+		switch {
+		case sameTreeFile(ourFile, inOurs, theirFile, inTheirs):
+			if inOurs {
+				merged[path] = ourFile
+			}
+		case sameTreeFile(ourFile, inOurs, baseFile, inBase):
+			if inTheirs {
+				merged[path] = theirFile
+			}
+		case sameTreeFile(theirFile, inTheirs, baseFile, inBase):
+			if inOurs {
+				merged[path] = ourFile
+			}
+		case inOurs && inTheirs && !ourFile.symlink && !theirFile.symlink && !baseFile.symlink &&
+			isText(ourFile.contents) && isText(theirFile.contents) && isText(baseFile.contents):
+			contents, conflicted := mergeText(string(baseFile.contents), string(ourFile.contents), string(theirFile.contents))
+			merged[path] = treeFile{contents: []byte(contents), mode: ourFile.mode}
+			if conflicted {
+				conflicts = append(conflicts, path)
+			}
+		default:
+			// Binary and symlink conflicts keep the project side; a file one
+			// side deleted keeps the side that modified it.
+			if inOurs {
+				merged[path] = ourFile
+			} else {
+				merged[path] = theirFile
+			}
+			conflicts = append(conflicts, path)
+		}
+	}
+
+	sort.Strings(conflicts)
+	return merged, conflicts
+}
+
+// mergeText performs a diff3-style line merge and reports whether conflict markers were written.
+func mergeText(base, ours, theirs string) (string, bool) {
+	baseLines := splitLines(base)
+	ourLines := splitLines(ours)
+	theirLines := splitLines(theirs)
+
+	ourMatch := baseMatchIndex(lineMatches(baseLines, ourLines), len(baseLines))
+	theirMatch := baseMatchIndex(lineMatches(baseLines, theirLines), len(baseLines))
+
+	var out strings.Builder
+	conflicted := false
+	i, j, k := 0, 0, 0
+	for {
+		// Copy the stable run where all three sides agree.
+		for i < len(baseLines) && ourMatch[i] == j && theirMatch[i] == k {
+			out.WriteString(baseLines[i])
+			i++
+			j++
+			k++
+		}
+		if i == len(baseLines) && j == len(ourLines) && k == len(theirLines) {
+			break
+		}
+
+		// The next base line matched on both sides closes the unstable chunk.
+		next := i
+		for next < len(baseLines) && (ourMatch[next] < 0 || theirMatch[next] < 0) {
+			next++
+		}
+		nextOurs, nextTheirs := len(ourLines), len(theirLines)
+		if next < len(baseLines) {
+			nextOurs, nextTheirs = ourMatch[next], theirMatch[next]
+		}
+
+		baseChunk := baseLines[i:next]
+		ourChunk := ourLines[j:nextOurs]
+		theirChunk := theirLines[k:nextTheirs]
+		switch {
+		case linesEqual(ourChunk, baseChunk):
+			writeLines(&out, theirChunk)
+		case linesEqual(theirChunk, baseChunk), linesEqual(ourChunk, theirChunk):
+			writeLines(&out, ourChunk)
+		default:
+			conflicted = true
+			out.WriteString("<<<<<<< " + mergeOursLabel + "\n")
+			writeConflictLines(&out, ourChunk)
+			out.WriteString("=======\n")
+			writeConflictLines(&out, theirChunk)
+			out.WriteString(">>>>>>> " + mergeTheirsLabel + "\n")
+		}
+
+		i, j, k = next, nextOurs, nextTheirs
+	}
+	return out.String(), conflicted
+}
+
+// baseMatchIndex maps each base line index to its matched index on the other side, or -1.
+func baseMatchIndex(matches [][2]int, baseLen int) []int {
+	index := make([]int, baseLen)
+	for i := range index {
+		index[i] = -1
+	}
+	for _, match := range matches {
+		index[match[0]] = match[1]
+	}
+	return index
+}
+
+func linesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeLines(out *strings.Builder, lines []string) {
+	for _, line := range lines {
+		out.WriteString(line)
+	}
+}
+
+// writeConflictLines writes lines and terminates the last one so markers stay on their own line.
+func writeConflictLines(out *strings.Builder, lines []string) {
+	writeLines(out, lines)
+	if len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+		out.WriteString("\n")
+	}
+}
+
+func sameTreeFile(a treeFile, inA bool, b treeFile, inB bool) bool {
+	if inA != inB {
+		return false
+	}
+	if !inA {
+		return true
+	}
+	return a.symlink == b.symlink && bytes.Equal(a.contents, b.contents)
+}
+
+func treeFilesEqual(a, b map[string]treeFile) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for path, fileA := range a {
+		fileB, ok := b[path]
+		if !sameTreeFile(fileA, true, fileB, ok) {
+			return false
+		}
+	}
+	return true
+}
+
+// writeTreeFiles materializes files under dir, creating parent directories as needed.
+func writeTreeFiles(dir string, dirMode fs.FileMode, files map[string]treeFile) error {
+	if err := os.Chmod(dir, dirMode); err != nil {
+		return err
+	}
+
+	for path, file := range files {
+		dest := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+			return err
+		}
+
+		if file.symlink {
+			if err := os.Symlink(string(file.contents), dest); err != nil {
+				return err
+			}
+			continue
+		}
+
+		mode := file.mode
+		if mode == 0 {
+			mode = 0o644
+		}
+		if err := os.WriteFile(dest, file.contents, mode); err != nil {
+			return fmt.Errorf("write merged file %q: %w", path, err)
+		}
+		if err := os.Chmod(dest, mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMergeTextCombinesNonOverlappingEdits(t *testing.T) {
+	base := "a\nb\nc\nd\ne\n"
+	ours := "A\nb\nc\nd\ne\n"
+	theirs := "a\nb\nc\nd\nE\nf\n"
+
+	got, conflicted := mergeText(base, ours, theirs)
+	if conflicted {
+		t.Fatalf("mergeText() conflicted, output %q", got)
+	}
+	if want := "A\nb\nc\nd\nE\nf\n"; got != want {
+		t.Fatalf("mergeText() = %q, want %q", got, want)
+	}
+}
+
+func TestMergeTextWritesConflictMarkers(t *testing.T) {
+	base := "a\nb\nc\n"
+	ours := "a\nproject\nc\n"
+	theirs := "a\nstore\nc\n"
+
+	got, conflicted := mergeText(base, ours, theirs)
+	if !conflicted {
+		t.Fatalf("mergeText() conflicted = false, output %q", got)
+	}
+	want := "a\n<<<<<<< project\nproject\n=======\nstore\n>>>>>>> store\nc\n"
+	if got != want {
+		t.Fatalf("mergeText() = %q, want %q", got, want)
+	}
+}
+
+func TestMergeTextTakesIdenticalEdits(t *testing.T) {
+	got, conflicted := mergeText("a\n", "b\n", "b\n")
+	if conflicted || got != "b\n" {
+		t.Fatalf("mergeText() = %q, %v; want %q without conflict", got, conflicted, "b\n")
+	}
+}
+
+func TestMergeWritesCleanMergeToStore(t *testing.T) {
+	tmp := t.TempDir()
+	base := filepath.Join(tmp, "base", "go")
+	project := filepath.Join(tmp, "project", "go")
+	store := filepath.Join(tmp, "store", "go")
+
+	for _, dir := range []string{base, project, store} {
+		mustMkdirAll(t, dir)
+		mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "title\nbody\nfooter\n")
+	}
+	mustWriteFile(t, filepath.Join(project, "SKILL.md"), "project title\nbody\nfooter\n")
+	mustWriteFile(t, filepath.Join(store, "SKILL.md"), "title\nbody\nstore footer\n")
+	mustWriteFile(t, filepath.Join(project, "added.md"), "new\n")
+
+	result, err := Merge(base, project, store)
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if !result.Changed || len(result.Conflicts) != 0 {
+		t.Fatalf("Merge() = %+v, want changed without conflicts", result)
+	}
+
+	skill, err := os.ReadFile(filepath.Join(store, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile(store SKILL.md) error = %v", err)
+	}
+	if want := "project title\nbody\nstore footer\n"; string(skill) != want {
+		t.Fatalf("store SKILL.md = %q, want %q", string(skill), want)
+	}
+	if _, err := os.Stat(filepath.Join(store, "added.md")); err != nil {
+		t.Fatalf("Stat(store added.md) error = %v", err)
+	}
+}
+
+func TestMergeWritesConflictsToProjectAndLeavesStoreAlone(t *testing.T) {
+	tmp := t.TempDir()
+	base := filepath.Join(tmp, "base", "go")
+	project := filepath.Join(tmp, "project", "go")
+	store := filepath.Join(tmp, "store", "go")
+
+	for _, dir := range []string{base, project, store} {
+		mustMkdirAll(t, dir)
+		mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "title\nbody\nfooter\n")
+		mustWriteFile(t, filepath.Join(dir, "notes.md"), "one\n")
+	}
+	mustWriteFile(t, filepath.Join(project, "SKILL.md"), "title\nproject body\nfooter\n")
+	mustWriteFile(t, filepath.Join(store, "SKILL.md"), "title\nstore body\nfooter\n")
+	mustWriteFile(t, filepath.Join(project, "notes.md"), "two\n")
+	if err := os.Remove(filepath.Join(store, "notes.md")); err != nil {
+		t.Fatalf("Remove(store notes.md) error = %v", err)
+	}
+
+	result, err := Merge(base, project, store)
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if result.Changed {
+		t.Fatal("Merge() Changed = true, want store left alone")
+	}
+	if len(result.Conflicts) != 2 || result.Conflicts[0] != "SKILL.md" || result.Conflicts[1] != "notes.md" {
+		t.Fatalf("Merge() Conflicts = %v, want [SKILL.md notes.md]", result.Conflicts)
+	}
+
+	skill, err := os.ReadFile(filepath.Join(project, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile(project SKILL.md) error = %v", err)
+	}
+	want := "title\n<<<<<<< project\nproject body\n=======\nstore body\n>>>>>>> store\nfooter\n"
+	if string(skill) != want {
+		t.Fatalf("project SKILL.md = %q, want %q", string(skill), want)
+	}
+	if notes, err := os.ReadFile(filepath.Join(project, "notes.md")); err != nil || string(notes) != "two\n" {
+		t.Fatalf("project notes.md = %q, %v; delete/modify conflict should keep the project edit", notes, err)
+	}
+	if stored, err := os.ReadFile(filepath.Join(store, "SKILL.md")); err != nil || string(stored) != "title\nstore body\nfooter\n" {
+		t.Fatalf("store SKILL.md = %q, %v; want untouched", stored, err)
+	}
+	if _, err := os.Stat(filepath.Join(store, "notes.md")); !os.IsNotExist(err) {
+		t.Fatalf("Stat(store notes.md) error = %v, want still deleted", err)
+	}
+}
+
+func TestMergeLeavesStoreUntouchedWhenProjectUnchanged(t *testing.T) {
+	tmp := t.TempDir()
+	base := filepath.Join(tmp, "base", "go")
+	project := filepath.Join(tmp, "project", "go")
+	store := filepath.Join(tmp, "store", "go")
+
+	for _, dir := range []string{base, project, store} {
+		mustMkdirAll(t, dir)
+		mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "v1\n")
+	}
+	mustWriteFile(t, filepath.Join(store, "SKILL.md"), "v2\n")
+
+	result, err := Merge(base, project, store)
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if result.Changed || len(result.Conflicts) != 0 {
+		t.Fatalf("Merge() = %+v, want unchanged without conflicts", result)
+	}
+}
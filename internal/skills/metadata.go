@@ -0,0 +1,173 @@
+package skills
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Metadata is the typed frontmatter of a SKILL.md.
+type Metadata struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version,omitempty"`
+	// Tags accepts a YAML list or a comma-separated string.
+	Tags    []string `json:"tags,omitempty"`
+	License string   `json:"license,omitempty"`
+	// AllowedTools accepts a YAML list or a space-separated string.
+	AllowedTools []string `json:"allowed_tools,omitempty"`
+	// Metadata holds the free-form string map under the metadata key.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Requires names other skills this skill depends on.
+	Requires []string `json:"requires,omitempty"`
+}
+
+// ParseMetadata decodes SKILL.md frontmatter. Known fields with an unexpected
+// type are left empty and reported as issues; unknown fields are ignored.
+func ParseMetadata(frontmatter string) (Metadata, []ValidationIssue, error) {
+	// Decoding into a map first rejects duplicate keys and non-mapping documents.
+	if err := yaml.Unmarshal([]byte(frontmatter), &map[string]any{}); err != nil {
+		return Metadata{}, nil, err
+	}
+
+	doc := yaml.Node{}
+	if err := yaml.Unmarshal([]byte(frontmatter), &doc); err != nil {
+		return Metadata{}, nil, err
+	}
+	meta := Metadata{}
+	if len(doc.Content) == 0 {
+		return meta, nil, nil
+	}
+
+	var issues []ValidationIssue
+	fields := doc.Content[0].Content
+	for i := 0; i+1 < len(fields); i += 2 {
+		key, value := fields[i].Value, fields[i+1]
+
+		var ok bool
+		want := "a string"
+		switch key {
+		case "name":
+			// Name and description problems are reported by their required checks.
+			meta.Name, _ = stringNode(value)
+			continue
+		case "description":
+			meta.Description, _ = stringNode(value)
+			continue
+		case "version":
+			meta.Version, ok = scalarNode(value)
+		case "license":
+			meta.License, ok = stringNode(value)
+		case "tags":
+			meta.Tags, ok = listNode(value, ",")
+			want = "a list of strings or a comma-separated string"
+		case "allowed-tools":
+			meta.AllowedTools, ok = listNode(value, " ")
+			want = "a list of strings or a space-separated string"
+		case "requires":
+			meta.Requires, ok = listNode(value, ",")
+			want = "a list of skill names"
+		case "metadata":
+			meta.Metadata, ok = mapNode(value)
+			want = "a map of string values"
+		default:
+			continue
+		}
+		if !ok {
+			issues = append(issues, ValidationIssue{
+				Rule:    key,
+				Message: fmt.Sprintf("frontmatter field %q must be %s", key, want),
+			})
+		}
+	}
+	return meta, issues, nil
+}
+
+// ReadMetadata parses the frontmatter of SKILL.md in skillDir. Missing or
+// invalid frontmatter yields empty metadata; validation reports those cases.
+func ReadMetadata(skillDir string) Metadata {
+	raw, err := os.ReadFile(filepath.Join(skillDir, "SKILL.md"))
+	if err != nil {
+		return Metadata{}
+	}
+	frontmatter, ok := extractFrontmatter(string(raw))
+	if !ok {
+		return Metadata{}
+	}
+	meta, _, err := ParseMetadata(frontmatter)
+	if err != nil {
+		return Metadata{}
+	}
+	return meta
+}
+
+// stringNode returns the trimmed value of a non-empty YAML string scalar.
+func stringNode(node *yaml.Node) (string, bool) {
+	if node.Kind != yaml.ScalarNode || node.Tag != "!!str" {
+		return "", false
+	}
+	value := strings.TrimSpace(node.Value)
+	return value, value != ""
+}
+
+// scalarNode returns any scalar as written, so version 1.10 stays "1.10".
+func scalarNode(node *yaml.Node) (string, bool) {
+	if node.Kind != yaml.ScalarNode || node.Tag == "!!null" {
+		return "", false
+	}
+	value := strings.TrimSpace(node.Value)
+	return value, value != ""
+}
+
+// listNode accepts a sequence of scalars or one string split on sep.
+func listNode(node *yaml.Node, sep string) ([]string, bool) {
+	var raw []string
+	switch node.Kind {
+	case yaml.ScalarNode:
+		value, ok := stringNode(node)
+		if !ok {
+			return nil, false
+		}
+		if sep == " " {
+			raw = strings.Fields(value)
+		} else {
+			raw = strings.Split(value, sep)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			value, ok := scalarNode(item)
+			if !ok {
+				return nil, false
+			}
+			raw = append(raw, value)
+		}
+	default:
+		return nil, false
+	}
+
+	values := make([]string, 0, len(raw))
+	for _, value := range raw {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values, true
+}
+
+// mapNode accepts a mapping of scalar values.
+func mapNode(node *yaml.Node) (map[string]string, bool) {
+	if node.Kind != yaml.MappingNode {
+		return nil, false
+	}
+	values := make(map[string]string, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i+1].Kind != yaml.ScalarNode {
+			return nil, false
+		}
+		values[node.Content[i].Value] = node.Content[i+1].Value
+	}
+	return values, true
+}
@@ -0,0 +1,69 @@
+package skills
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseMetadataReadsTypedFields(t *testing.T) {
+	frontmatter := `name: go
+description: Go conventions
+version: 1.10
+tags: [lang, backend]
+license: MIT
+allowed-tools: Bash Read
+metadata:
+  author: team
+requires:
+  - testing
+`
+	meta, issues, err := ParseMetadata(frontmatter)
+	if err != nil {
+		t.Fatalf("ParseMetadata() error = %v", err)
+	}
+	if len(issues) != 0 {
+		t.Fatalf("issues = %+v, want none", issues)
+	}
+
+	want := Metadata{
+		Name:         "go",
+		Description:  "Go conventions",
+		Version:      "1.10",
+		Tags:         []string{"lang", "backend"},
+		License:      "MIT",
+		AllowedTools: []string{"Bash", "Read"},
+		Metadata:     map[string]string{"author": "team"},
+		Requires:     []string{"testing"},
+	}
+	if !reflect.DeepEqual(meta, want) {
+		t.Fatalf("meta = %+v, want %+v", meta, want)
+	}
+}
+
+func TestParseMetadataReportsWrongTypes(t *testing.T) {
+	meta, issues, err := ParseMetadata("name: 42\ntags: {a: b}\ndescription: ok\n")
+	if err != nil {
+		t.Fatalf("ParseMetadata() error = %v", err)
+	}
+	if meta.Name != "" || meta.Tags != nil || meta.Description != "ok" {
+		t.Fatalf("meta = %+v, want only description", meta)
+	}
+	if len(issues) != 1 || issues[0].Rule != "tags" {
+		t.Fatalf("issues = %+v, want one tags issue", issues)
+	}
+}
+
+func TestDiscoverLoadsMetadata(t *testing.T) {
+	root := t.TempDir()
+	mustMkdirAll(t, filepath.Join(root, "go"))
+	mustWriteFile(t, filepath.Join(root, "go", "SKILL.md"), "---\nname: go\ndescription: Go\ntags: lang, backend\n---\n")
+
+	discovered, err := Discover(root)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(discovered) != 1 || discovered[0].Meta.Description != "Go" || !reflect.DeepEqual(discovered[0].Meta.Tags, []string{"lang", "backend"}) {
+		t.Fatalf("discovered = %+v, want go with metadata", discovered)
+	}
+}
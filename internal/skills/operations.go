@@ -0,0 +1,68 @@
+package skills
+
+import "fmt"
+
+// OperationKind names one filesystem change bond makes.
+type OperationKind string
+
+const (
+	OpMkdir      OperationKind = "mkdir"
+	OpSymlink    OperationKind = "symlink"
+	OpCopyTree   OperationKind = "copy"
+	OpReplace    OperationKind = "replace"
+	OpRemoveLink OperationKind = "remove_link"
+	OpWriteFile  OperationKind = "write"
+	OpRemoveTree OperationKind = "remove_tree"
+	OpMoveTree   OperationKind = "move"
+)
+
+// Operation is one planned or applied filesystem change.
+type Operation struct {
+	Kind OperationKind `json:"kind" yaml:"kind"`
+	Path string        `json:"path" yaml:"path"`
+	// Source is the symlink target or the tree copied or moved into Path. For
+	// a removed link it is the target the link pointed to.
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
+	// Backup is where a replaced tree is kept.
+	Backup string `json:"backup,omitempty" yaml:"backup,omitempty"`
+	// Digest is the digest of a copied tree, recorded once the copy is made,
+	// so reverting it can tell whether the copy was edited since.
+	Digest string `json:"digest,omitempty" yaml:"digest,omitempty"`
+}
+
+// Reversible reports whether RevertOperation can undo op.
+func (op Operation) Reversible() bool {
+	switch op.Kind {
+	case OpSymlink, OpCopyTree, OpRemoveLink:
+		return true
+	default:
+		return false
+	}
+}
+
+// String renders op as a short human-readable description.
+func (op Operation) String() string {
+	switch op.Kind {
+	case OpSymlink:
+		return fmt.Sprintf("symlink %s -> %s", op.Path, op.Source)
+	case OpCopyTree:
+		return fmt.Sprintf("copy tree %s -> %s", op.Source, op.Path)
+	case OpReplace:
+		if op.Backup != "" {
+			return fmt.Sprintf("replace tree %s with %s (backup %s)", op.Path, op.Source, op.Backup)
+		}
+		return fmt.Sprintf("replace tree %s with %s", op.Path, op.Source)
+	case OpRemoveLink:
+		return fmt.Sprintf("remove link %s", op.Path)
+	case OpMkdir:
+		return fmt.Sprintf("create directory %s", op.Path)
+	case OpWriteFile:
+		return fmt.Sprintf("write file %s", op.Path)
+	case OpRemoveTree:
+		return fmt.Sprintf("remove tree %s", op.Path)
+	case OpMoveTree:
+		return fmt.Sprintf("move tree %s -> %s", op.Source, op.Path)
+	default:
+		return fmt.Sprintf("%s %s", op.Kind, op.Path)
+	}
+}
@@ -64,6 +64,7 @@ func DiscoverProjectAll(projectSkillsDir string) ([]Skill, error) {
 		skills = append(skills, Skill{
 			Name: entry.Name(),
 			Path: entryPath,
+			Meta: ReadMetadata(checkPath),
 		})
 	}
 
@@ -117,6 +118,7 @@ func DiscoverProjectStorable(projectSkillsDir string) ([]Skill, error) {
 		skills = append(skills, Skill{
 			Name: entry.Name(),
 			Path: skillPath,
+			Meta: ReadMetadata(skillPath),
 		})
 	}
 
@@ -0,0 +1,151 @@
+package skills
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const registryHeader = "# Generated by bond. Projects are added by link, copy, sync, update and init.\n"
+
+// RegisteredProject is one project bond linked or copied skills into.
+type RegisteredProject struct {
+	Path     string    `yaml:"path" json:"path"`
+	LastUsed time.Time `yaml:"last_used" json:"last_used"`
+}
+
+// ProjectRegistry lists known projects, sorted by path.
+type ProjectRegistry struct {
+	Projects []RegisteredProject `yaml:"projects"`
+}
+
+// LoadProjectRegistry reads the registry at path. A missing file yields an empty registry.
+func LoadProjectRegistry(path string) (ProjectRegistry, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ProjectRegistry{}, nil
+		}
+		return ProjectRegistry{}, err
+	}
+
+	var registry ProjectRegistry
+	if err := yaml.Unmarshal(raw, &registry); err != nil {
+		return ProjectRegistry{}, fmt.Errorf("invalid project registry %q: %w", path, err)
+	}
+	return registry, nil
+}
+
+// SaveProjectRegistry writes registry to path, creating its directory when needed.
+func SaveProjectRegistry(path string, registry ProjectRegistry) error {
+	raw, err := yaml.Marshal(registry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte(registryHeader), raw...), 0o644)
+}
+
+// Register adds root, or refreshes its last use time when it is already known.
+func (r *ProjectRegistry) Register(root string, at time.Time) {
+	at = at.UTC().Truncate(time.Second)
+	for i := range r.Projects {
+		if r.Projects[i].Path == root {
+			r.Projects[i].LastUsed = at
+			return
+		}
+	}
+	r.Projects = append(r.Projects, RegisteredProject{Path: root, LastUsed: at})
+	sort.Slice(r.Projects, func(i, j int) bool { return r.Projects[i].Path < r.Projects[j].Path })
+}
+
+// Prune drops projects whose directory no longer exists and returns them.
+func (r *ProjectRegistry) Prune() ([]RegisteredProject, error) {
+	kept := r.Projects[:0]
+	pruned := []RegisteredProject{}
+	for _, project := range r.Projects {
+		info, err := os.Stat(project.Path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		if err != nil || !info.IsDir() {
+			pruned = append(pruned, project)
+			continue
+		}
+		kept = append(kept, project)
+	}
+	r.Projects = kept
+	return pruned, nil
+}
+
+// UsageMode says how a project uses a store skill.
+type UsageMode string
+
+const (
+	UsageLink UsageMode = "link"
+	UsageCopy UsageMode = "copy"
+)
+
+// SkillUsage is one place a project uses a store skill.
+type SkillUsage struct {
+	Path   string     `json:"path"`
+	Mode   UsageMode  `json:"mode"`
+	Status StatusKind `json:"status"`
+}
+
+// FindSkillUsage reports where projectSkillsDir links to skill or holds a copy
+// of it. A copy counts when bond.lock records skill as its source, or, without
+// a record, when skill is the store skill it is compared with.
+func FindSkillUsage(stores []StoreDir, projectSkillsDir string, lock Lockfile, skill Skill) ([]SkillUsage, error) {
+	report, err := InspectProjectStatus(stores, projectSkillsDir, lock)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := []SkillUsage{}
+	for _, entry := range report.Entries {
+		if entry.Name != skill.Name {
+			continue
+		}
+		switch entry.Status {
+		case StatusLinked:
+			same, err := samePath(entry.Path, skill.Path)
+			if err != nil {
+				return nil, err
+			}
+			if same {
+				usages = append(usages, SkillUsage{Path: entry.Path, Mode: UsageLink, Status: entry.Status})
+			}
+		case StatusCopied, StatusModified, StatusOutdated, StatusConflict:
+			recorded, ok := lock.Skills[entry.Name]
+			copied := ok && filepath.Clean(recorded.Source) == filepath.Clean(skill.Path)
+			if !ok {
+				copied = entry.Store != "" && entry.Store == skill.Store
+			}
+			if copied {
+				usages = append(usages, SkillUsage{Path: entry.Path, Mode: UsageCopy, Status: entry.Status})
+			}
+		}
+	}
+	return usages, nil
+}
+
+// samePath reports whether a and b resolve to the same location.
+func samePath(a, b string) (bool, error) {
+	resolvedA, err := filepath.EvalSymlinks(a)
+	if err != nil {
+		return false, err
+	}
+	resolvedB, err := filepath.EvalSymlinks(b)
+	if err != nil {
+		return false, err
+	}
+	return resolvedA == resolvedB, nil
+}
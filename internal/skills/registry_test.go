@@ -0,0 +1,94 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestProjectRegistryRegisterRoundTripAndPrune(t *testing.T) {
+	tmp := t.TempDir()
+	kept := filepath.Join(tmp, "kept")
+	gone := filepath.Join(tmp, "gone")
+	mustMkdirAll(t, kept)
+
+	registry := ProjectRegistry{}
+	first := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	registry.Register(kept, first)
+	registry.Register(gone, first)
+	registry.Register(kept, first.Add(time.Hour))
+
+	path := filepath.Join(tmp, "state", "projects.yaml")
+	if err := SaveProjectRegistry(path, registry); err != nil {
+		t.Fatalf("SaveProjectRegistry() error = %v", err)
+	}
+	loaded, err := LoadProjectRegistry(path)
+	if err != nil {
+		t.Fatalf("LoadProjectRegistry() error = %v", err)
+	}
+	if len(loaded.Projects) != 2 || loaded.Projects[0].Path != gone || !loaded.Projects[1].LastUsed.Equal(first.Add(time.Hour)) {
+		t.Fatalf("projects = %+v, want gone then refreshed kept", loaded.Projects)
+	}
+
+	pruned, err := loaded.Prune()
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+	if len(pruned) != 1 || pruned[0].Path != gone {
+		t.Fatalf("pruned = %+v, want %s", pruned, gone)
+	}
+	if len(loaded.Projects) != 1 || loaded.Projects[0].Path != kept {
+		t.Fatalf("projects = %+v, want only %s", loaded.Projects, kept)
+	}
+}
+
+func TestFindSkillUsageReportsLinksAndRecordedCopies(t *testing.T) {
+	tmp := t.TempDir()
+	personal := filepath.Join(tmp, "personal")
+	team := filepath.Join(tmp, "team")
+	linkedDir := filepath.Join(tmp, "linked", ".agents", "skills")
+	copiedDir := filepath.Join(tmp, "copied", ".agents", "skills")
+	stores := []StoreDir{{Name: "personal", Path: personal}, {Name: "team", Path: team}}
+
+	for _, store := range []string{personal, team} {
+		mustMkdirAll(t, filepath.Join(store, "go"))
+		mustWriteFile(t, filepath.Join(store, "go", "SKILL.md"), "go from "+filepath.Base(store))
+	}
+	mustMkdirAll(t, linkedDir)
+	mustMkdirAll(t, filepath.Join(copiedDir, "go"))
+	if err := os.Symlink(filepath.Join(team, "go"), filepath.Join(linkedDir, "go")); err != nil {
+		t.Fatalf("Symlink() error = %v", err)
+	}
+	mustWriteFile(t, filepath.Join(copiedDir, "go", "SKILL.md"), "go from personal")
+	lock := Lockfile{}
+	if err := lock.RecordCopy("go", filepath.Join(personal, "go"), filepath.Join(copiedDir, "go"), time.Now()); err != nil {
+		t.Fatalf("RecordCopy() error = %v", err)
+	}
+	mustWriteFile(t, filepath.Join(copiedDir, "go", "SKILL.md"), "edited")
+
+	personalGo := Skill{Name: "go", Path: filepath.Join(personal, "go"), Store: "personal"}
+	teamGo := Skill{Name: "go", Path: filepath.Join(team, "go"), Store: "team"}
+
+	usages, err := FindSkillUsage(stores, linkedDir, Lockfile{}, teamGo)
+	if err != nil {
+		t.Fatalf("FindSkillUsage(linked, team) error = %v", err)
+	}
+	if len(usages) != 1 || usages[0].Mode != UsageLink || usages[0].Status != StatusLinked {
+		t.Fatalf("linked usages = %+v, want one link", usages)
+	}
+	if usages, err := FindSkillUsage(stores, linkedDir, Lockfile{}, personalGo); err != nil || len(usages) != 0 {
+		t.Fatalf("FindSkillUsage(linked, personal) = %+v, %v, want none", usages, err)
+	}
+
+	usages, err = FindSkillUsage(stores, copiedDir, lock, personalGo)
+	if err != nil {
+		t.Fatalf("FindSkillUsage(copied, personal) error = %v", err)
+	}
+	if len(usages) != 1 || usages[0].Mode != UsageCopy || usages[0].Status != StatusModified {
+		t.Fatalf("copied usages = %+v, want one modified copy", usages)
+	}
+	if usages, err := FindSkillUsage(stores, copiedDir, lock, teamGo); err != nil || len(usages) != 0 {
+		t.Fatalf("FindSkillUsage(copied, team) = %+v, %v, want none", usages, err)
+	}
+}
@@ -0,0 +1,50 @@
+package skills
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SetSkillName rewrites the name field in the SKILL.md frontmatter of
+// skillDir, adding it when missing. Everything else, including a byte order
+// mark and the line endings, is kept as written.
+func SetSkillName(skillDir, name string) error {
+	marker := filepath.Join(skillDir, "SKILL.md")
+	info, err := os.Stat(marker)
+	if err != nil {
+		return err
+	}
+	raw, err := os.ReadFile(marker)
+	if err != nil {
+		return err
+	}
+	if _, _, ok := splitFrontmatter(string(raw)); !ok {
+		return fmt.Errorf("%q has no YAML frontmatter", marker)
+	}
+
+	// The opening delimiter is lines[0]; the name goes on its own line ending.
+	lines := strings.SplitAfter(string(raw), "\n")
+	opening := lines[0]
+	ending := opening[len(strings.TrimRight(opening, "\r\n")):]
+	field := "name: " + name
+
+	replaced := false
+	for i := 1; i < len(lines); i++ {
+		text := strings.TrimRight(lines[i], "\r\n")
+		if text == "---" {
+			break
+		}
+		// Only the top-level key counts; indented name fields belong to nested maps.
+		if strings.HasPrefix(text, "name:") {
+			lines[i] = field + lines[i][len(text):]
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		lines = append([]string{opening, field + ending}, lines[1:]...)
+	}
+	return os.WriteFile(marker, []byte(strings.Join(lines, "")), info.Mode().Perm())
+}
@@ -0,0 +1,71 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetSkillNameRewritesOnlyTheNameLine(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "golang")
+	mustMkdirAll(t, dir)
+	mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "---\nname: go\ndescription: Go tips\nmetadata:\n  name: nested\n---\n# Go\n")
+
+	if err := SetSkillName(dir, "golang"); err != nil {
+		t.Fatalf("SetSkillName() error = %v", err)
+	}
+	raw, err := os.ReadFile(filepath.Join(dir, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if want := "---\nname: golang\ndescription: Go tips\nmetadata:\n  name: nested\n---\n# Go\n"; string(raw) != want {
+		t.Fatalf("SKILL.md = %q, want %q", raw, want)
+	}
+}
+
+func TestSetSkillNameAddsMissingNameAndRejectsMissingFrontmatter(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "golang")
+	mustMkdirAll(t, dir)
+	mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "---\ndescription: Go tips\n---\nbody\n")
+
+	if err := SetSkillName(dir, "golang"); err != nil {
+		t.Fatalf("SetSkillName() error = %v", err)
+	}
+	if result, err := ValidateSkillDir(dir); err != nil || len(result.Issues) != 0 {
+		t.Fatalf("ValidateSkillDir() = %+v, %v, want no issues", result, err)
+	}
+
+	mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "no frontmatter")
+	if err := SetSkillName(dir, "golang"); err == nil {
+		t.Fatal("SetSkillName() error = nil, want missing frontmatter error")
+	}
+}
+
+func TestSetSkillNameKeepsLineEndingsAndByteOrderMark(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "golang")
+	mustMkdirAll(t, dir)
+	mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "\uFEFF---\r\nname: go\r\ndescription: Go tips\r\n---\r\n# Go\r\n")
+
+	if err := SetSkillName(dir, "golang"); err != nil {
+		t.Fatalf("SetSkillName() error = %v", err)
+	}
+	raw, err := os.ReadFile(filepath.Join(dir, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if want := "\uFEFF---\r\nname: golang\r\ndescription: Go tips\r\n---\r\n# Go\r\n"; string(raw) != want {
+		t.Fatalf("SKILL.md = %q, want %q", raw, want)
+	}
+
+	mustWriteFile(t, filepath.Join(dir, "SKILL.md"), "---\r\ndescription: Go tips\r\n---\r\n")
+	if err := SetSkillName(dir, "golang"); err != nil {
+		t.Fatalf("SetSkillName() error = %v", err)
+	}
+	raw, err = os.ReadFile(filepath.Join(dir, "SKILL.md"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if want := "---\r\nname: golang\r\ndescription: Go tips\r\n---\r\n"; string(raw) != want {
+		t.Fatalf("SKILL.md = %q, want %q", raw, want)
+	}
+}
@@ -0,0 +1,136 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Search weights. A term matching the name outranks tags, which outrank
+// the description and then the body.
+const (
+	scoreNameExact    = 100
+	scoreNamePrefix   = 60
+	scoreNameContains = 40
+	scoreNameFuzzy    = 20
+	scoreTagExact     = 30
+	scoreTagContains  = 15
+	scoreDescription  = 10
+	scoreBody         = 5
+)
+
+// SearchResult is a skill matching a search query with its relevance score.
+type SearchResult struct {
+	Skill
+	Score int `json:"score"`
+}
+
+// Search ranks candidates against query. Every whitespace-separated term must
+// match the name, a tag or the description, or the SKILL.md body when
+// includeBody is set. Results are sorted by score, then name.
+func Search(candidates []Skill, query string, includeBody bool) ([]SearchResult, error) {
+	terms := strings.Fields(strings.ToLower(query))
+	results := []SearchResult{}
+	if len(terms) == 0 {
+		return results, nil
+	}
+
+	for _, skill := range candidates {
+		body := ""
+		if includeBody {
+			raw, err := os.ReadFile(filepath.Join(skill.Path, "SKILL.md"))
+			if err != nil {
+				return nil, err
+			}
+			_, rest, ok := splitFrontmatter(string(raw))
+			if !ok {
+				rest = string(raw)
+			}
+			body = strings.ToLower(rest)
+		}
+
+		total := 0
+		for _, term := range terms {
+			score := scoreTerm(skill, term, body)
+			if score == 0 {
+				total = 0
+				break
+			}
+			total += score
+		}
+		if total > 0 {
+			results = append(results, SearchResult{Skill: skill, Score: total})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+	return results, nil
+}
+
+// scoreTerm returns the best score of term against one skill, or zero.
+func scoreTerm(skill Skill, term, body string) int {
+	name := strings.ToLower(skill.Name)
+	best := 0
+	keep := func(score int) {
+		if score > best {
+			best = score
+		}
+	}
+
+	switch {
+	case name == term:
+		keep(scoreNameExact)
+	case strings.HasPrefix(name, term):
+		keep(scoreNamePrefix)
+	case strings.Contains(name, term):
+		keep(scoreNameContains)
+	default:
+		keep(fuzzyScore(name, term))
+	}
+
+	for _, tag := range skill.Meta.Tags {
+		tag = strings.ToLower(tag)
+		if tag == term {
+			keep(scoreTagExact)
+		} else if strings.Contains(tag, term) {
+			keep(scoreTagContains)
+		}
+	}
+	if strings.Contains(strings.ToLower(skill.Meta.Description), term) {
+		keep(scoreDescription)
+	}
+	if body != "" && strings.Contains(body, term) {
+		keep(scoreBody)
+	}
+	return best
+}
+
+// fuzzyScore matches term as a subsequence of name. Every skipped character
+// between matches costs a point, so "gtst" ranks "go-test" above "go-integration-test".
+func fuzzyScore(name, term string) int {
+	gaps, pos := 0, 0
+	started := false
+	for _, r := range term {
+		idx := strings.IndexRune(name[pos:], r)
+		if idx < 0 {
+			return 0
+		}
+		if started {
+			gaps += idx
+		}
+		started = true
+		pos += idx + len(string(r))
+	}
+
+	score := scoreNameFuzzy - gaps
+	if score < 1 {
+		score = 1
+	}
+	return score
+}
@@ -0,0 +1,61 @@
+package skills
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSearchRanksNameTagAndDescriptionMatches(t *testing.T) {
+	candidates := []Skill{
+		{Name: "go-integration-test", Meta: Metadata{Description: "Integration tests"}},
+		{Name: "go-test", Meta: Metadata{Description: "Unit tests in Go"}},
+		{Name: "react", Meta: Metadata{Description: "React components", Tags: []string{"frontend"}}},
+		{Name: "vue", Meta: Metadata{Description: "Vue components", Tags: []string{"frontend-framework"}}},
+		{Name: "css", Meta: Metadata{Description: "Styles for the frontend"}},
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "go-test", want: []string{"go-test", "go-integration-test"}},
+		{query: "gtst", want: []string{"go-test", "go-integration-test"}},
+		{query: "frontend", want: []string{"react", "vue", "css"}},
+		{query: "components frontend", want: []string{"react", "vue"}},
+		{query: "rust", want: []string{}},
+	}
+	for _, tt := range tests {
+		results, err := Search(candidates, tt.query, false)
+		if err != nil {
+			t.Fatalf("Search(%q) error = %v", tt.query, err)
+		}
+		got := make([]string, 0, len(results))
+		for _, result := range results {
+			got = append(got, result.Name)
+		}
+		if len(got) != len(tt.want) {
+			t.Fatalf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Fatalf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestSearchMatchesBodyOnlyWhenAsked(t *testing.T) {
+	root := t.TempDir()
+	mustMkdirAll(t, filepath.Join(root, "go"))
+	mustWriteFile(t, filepath.Join(root, "go", "SKILL.md"), "---\nname: go\ndescription: Go\n---\nAlways run golangci-lint.\n")
+	candidates := []Skill{{Name: "go", Path: filepath.Join(root, "go"), Meta: Metadata{Description: "Go"}}}
+
+	results, err := Search(candidates, "golangci", false)
+	if err != nil || len(results) != 0 {
+		t.Fatalf("Search(no body) = %v, %v, want no results", results, err)
+	}
+	results, err = Search(candidates, "golangci", true)
+	if err != nil || len(results) != 1 || results[0].Score != scoreBody {
+		t.Fatalf("Search(body) = %+v, %v, want one body match", results, err)
+	}
+}
@@ -0,0 +1,207 @@
+package skills
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Selector prefixes and markers accepted by SelectSkills.
+const (
+	selectorExclude = "!"
+	selectorTag     = "tag:"
+	globChars       = "*?["
+)
+
+// Selection is the result of applying selectors to candidate skills.
+type Selection struct {
+	Skills []Skill
+	// Unmatched lists include selectors that matched no candidate.
+	Unmatched []string
+}
+
+// SelectSkills applies selectors to candidates. A selector is a skill name, a
+// store:skill name, a glob such as react-*, or tag:name; a leading ! turns any
+// of them into an exclusion. all starts from every candidate. The result keeps
+// selector order, then candidate order, and holds each skill name once, so
+// earlier candidates shadow later ones with the same name.
+func SelectSkills(candidates []Skill, selectors []string, all bool) (Selection, error) {
+	var includes, excludes []string
+	for _, selector := range selectors {
+		if strings.HasPrefix(selector, selectorExclude) {
+			excludes = append(excludes, strings.TrimPrefix(selector, selectorExclude))
+			continue
+		}
+		includes = append(includes, selector)
+	}
+	if len(excludes) > 0 && len(includes) == 0 && !all {
+		return Selection{}, fmt.Errorf("exclusions need --all or a selector to exclude from")
+	}
+
+	selection := Selection{Skills: []Skill{}}
+	seen := map[string]struct{}{}
+	add := func(skill Skill) {
+		if _, exists := seen[skill.Name]; exists {
+			return
+		}
+		seen[skill.Name] = struct{}{}
+		selection.Skills = append(selection.Skills, skill)
+	}
+
+	if all {
+		for _, skill := range candidates {
+			add(skill)
+		}
+	}
+	for _, selector := range includes {
+		matched, err := matchSelector(candidates, selector)
+		if err != nil {
+			return Selection{}, err
+		}
+		if len(matched) == 0 {
+			selection.Unmatched = append(selection.Unmatched, selector)
+			continue
+		}
+		for _, skill := range matched {
+			add(skill)
+		}
+	}
+
+	if len(excludes) == 0 {
+		return selection, nil
+	}
+	kept := selection.Skills[:0]
+	for _, skill := range selection.Skills {
+		excluded := false
+		for _, selector := range excludes {
+			ok, err := selectorMatches(skill, selector)
+			if err != nil {
+				return Selection{}, err
+			}
+			if ok {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			kept = append(kept, skill)
+		}
+	}
+	selection.Skills = kept
+	return selection, nil
+}
+
+// matchSelector returns the candidates selector matches. A plain name matches
+// only its first candidate, which is the visible one when stores shadow each other.
+func matchSelector(candidates []Skill, selector string) ([]Skill, error) {
+	matched := []Skill{}
+	for _, skill := range candidates {
+		ok, err := selectorMatches(skill, selector)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		matched = append(matched, skill)
+		if IsPlainSelector(selector) {
+			break
+		}
+	}
+	return matched, nil
+}
+
+// selectorMatches reports whether one include selector, without a leading !, matches skill.
+func selectorMatches(skill Skill, selector string) (bool, error) {
+	if tag, ok := strings.CutPrefix(selector, selectorTag); ok {
+		for _, skillTag := range skill.Meta.Tags {
+			if strings.EqualFold(skillTag, tag) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	name := skill.Name
+	if strings.Contains(selector, ":") {
+		if skill.Store == "" {
+			return false, nil
+		}
+		name = skill.Store + ":" + skill.Name
+	}
+	if !strings.ContainsAny(selector, globChars) {
+		return name == selector, nil
+	}
+	ok, err := path.Match(selector, name)
+	if err != nil {
+		return false, fmt.Errorf("invalid selector %q: %w", selector, err)
+	}
+	return ok, nil
+}
+
+// IsPlainSelector reports whether selector names one skill rather than a pattern or tag.
+func IsPlainSelector(selector string) bool {
+	return !strings.HasPrefix(selector, selectorTag) && !strings.ContainsAny(selector, globChars)
+}
+
+// SuggestSkillNames returns up to three candidate names close to selector by
+// edit distance, closest first. Patterns and tags get no suggestions.
+func SuggestSkillNames(candidates []Skill, selector string) []string {
+	if !IsPlainSelector(selector) {
+		return nil
+	}
+	if _, name, ok := strings.Cut(selector, ":"); ok {
+		selector = name
+	}
+
+	type suggestion struct {
+		name     string
+		distance int
+	}
+	limit := len([]rune(selector))/3 + 1
+	if limit < 2 {
+		limit = 2
+	}
+
+	seen := map[string]struct{}{}
+	suggestions := []suggestion{}
+	for _, skill := range candidates {
+		if _, exists := seen[skill.Name]; exists {
+			continue
+		}
+		seen[skill.Name] = struct{}{}
+		if distance := editDistance(selector, skill.Name); distance <= limit {
+			suggestions = append(suggestions, suggestion{name: skill.Name, distance: distance})
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool { return suggestions[i].distance < suggestions[j].distance })
+
+	names := []string{}
+	for i := 0; i < len(suggestions) && i < 3; i++ {
+		names = append(names, suggestions[i].name)
+	}
+	return names
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,70 @@
+package skills
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSelectSkillsAppliesSelectors(t *testing.T) {
+	candidates := []Skill{
+		{Name: "go", Store: "personal"},
+		{Name: "legacy-react", Store: "personal", Meta: Metadata{Tags: []string{"frontend"}}},
+		{Name: "react-hooks", Store: "personal", Meta: Metadata{Tags: []string{"Frontend"}}},
+		{Name: "react-router", Store: "personal"},
+		{Name: "go", Store: "team"},
+	}
+
+	tests := []struct {
+		name      string
+		selectors []string
+		all       bool
+		want      string
+		unmatched string
+	}{
+		{name: "plain names keep arg order", selectors: []string{"react-router", "go"}, want: "personal:react-router,personal:go"},
+		{name: "glob", selectors: []string{"react-*"}, want: "personal:react-hooks,personal:react-router"},
+		{name: "tag is case-insensitive", selectors: []string{"tag:frontend"}, want: "personal:legacy-react,personal:react-hooks"},
+		{name: "qualified name reaches shadowed skill", selectors: []string{"team:go"}, want: "team:go"},
+		{name: "all dedupes shadowed names", all: true, want: "personal:go,personal:legacy-react,personal:react-hooks,personal:react-router"},
+		{name: "exclusion", selectors: []string{"!legacy-*", "!go"}, all: true, want: "personal:react-hooks,personal:react-router"},
+		{name: "unmatched", selectors: []string{"rust", "py*", "go"}, want: "personal:go", unmatched: "rust,py*"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selection, err := SelectSkills(candidates, tt.selectors, tt.all)
+			if err != nil {
+				t.Fatalf("SelectSkills() error = %v", err)
+			}
+			got := make([]string, 0, len(selection.Skills))
+			for _, skill := range selection.Skills {
+				got = append(got, skill.Store+":"+skill.Name)
+			}
+			if strings.Join(got, ",") != tt.want {
+				t.Fatalf("selected = %v, want %s", got, tt.want)
+			}
+			if strings.Join(selection.Unmatched, ",") != tt.unmatched {
+				t.Fatalf("unmatched = %v, want %s", selection.Unmatched, tt.unmatched)
+			}
+		})
+	}
+}
+
+func TestSelectSkillsRejectsBareExclusions(t *testing.T) {
+	if _, err := SelectSkills([]Skill{{Name: "go"}}, []string{"!go"}, false); err == nil {
+		t.Fatal("SelectSkills() error = nil, want error for exclusions without includes")
+	}
+}
+
+func TestSuggestSkillNamesUsesEditDistance(t *testing.T) {
+	candidates := []Skill{{Name: "react"}, {Name: "reactive"}, {Name: "go"}, {Name: "rust"}}
+
+	if got := SuggestSkillNames(candidates, "raect"); strings.Join(got, ",") != "react" {
+		t.Fatalf("SuggestSkillNames(raect) = %v, want [react]", got)
+	}
+	if got := SuggestSkillNames(candidates, "python"); len(got) != 0 {
+		t.Fatalf("SuggestSkillNames(python) = %v, want none", got)
+	}
+	if got := SuggestSkillNames(candidates, "re*"); got != nil {
+		t.Fatalf("SuggestSkillNames(glob) = %v, want nil", got)
+	}
+}
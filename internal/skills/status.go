@@ -2,6 +2,7 @@ package skills
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
@@ -15,29 +16,63 @@ const (
 	StatusLinked   StatusKind = "linked"
 	StatusBroken   StatusKind = "broken"
 	StatusExternal StatusKind = "external"
+	// StatusCopied is a copy that matches its store skill.
+	StatusCopied StatusKind = "copied"
+	// StatusModified is a recorded copy changed in the project only.
+	StatusModified StatusKind = "modified"
+	// StatusOutdated is a recorded copy whose store skill changed since.
+	StatusOutdated StatusKind = "outdated"
+	// StatusProjectOnly is a directory with no store skill of the same name.
+	StatusProjectOnly StatusKind = "project_only"
+	// StatusConflict is a file, or a directory that differs from its store
+	// skill without provenance to tell which side changed, or where both did.
 	StatusConflict StatusKind = "conflict"
 )
 
 // StatusEntry is a classified project-local skill entry.
 type StatusEntry struct {
-	Name   string
-	Path   string
-	Status StatusKind
+	Name   string     `json:"name"`
+	Path   string     `json:"path"`
+	Status StatusKind `json:"status"`
+	// Store names the store a linked entry points into, or the store a copy came from.
+	Store string `json:"store,omitempty"`
 }
 
 // StatusReport captures the health of project-local skill entries.
 type StatusReport struct {
-	ProjectSkillsDir string
-	StoreSkillsDir   string
-	Entries          []StatusEntry
+	ProjectSkillsDir string        `json:"project_skills_dir"`
+	StoreSkillsDir   string        `json:"store_skills_dir"`
+	Entries          []StatusEntry `json:"entries"`
 }
 
 // InspectStatus classifies project entries against the store skills directory.
 func InspectStatus(storeSkillsDir, projectSkillsDir string) (StatusReport, error) {
-	storeAbs, err := filepath.Abs(storeSkillsDir)
-	if err != nil {
-		return StatusReport{}, err
+	return InspectStatusStores([]StoreDir{{Path: storeSkillsDir}}, projectSkillsDir)
+}
+
+// InspectStatusStores classifies project entries against every store in stores.
+// Links into any store count as linked; the report names the first store.
+// Copies are compared with store skills by content only.
+func InspectStatusStores(stores []StoreDir, projectSkillsDir string) (StatusReport, error) {
+	return InspectProjectStatus(stores, projectSkillsDir, Lockfile{})
+}
+
+// InspectProjectStatus classifies project entries like InspectStatusStores,
+// and uses the copy provenance in lock to tell whether a copy changed in the
+// project, in the store, or both.
+func InspectProjectStatus(stores []StoreDir, projectSkillsDir string, lock Lockfile) (StatusReport, error) {
+	storeAbs := make([]StoreDir, 0, len(stores))
+	for _, store := range stores {
+		path, err := filepath.Abs(store.Path)
+		if err != nil {
+			return StatusReport{}, err
+		}
+		storeAbs = append(storeAbs, StoreDir{Name: store.Name, Path: path})
+	}
+	if len(storeAbs) == 0 {
+		return StatusReport{}, fmt.Errorf("no store directories configured")
 	}
+
 	projectAbs, err := filepath.Abs(projectSkillsDir)
 	if err != nil {
 		return StatusReport{}, err
@@ -45,7 +80,7 @@ func InspectStatus(storeSkillsDir, projectSkillsDir string) (StatusReport, error
 
 	report := StatusReport{
 		ProjectSkillsDir: projectAbs,
-		StoreSkillsDir:   storeAbs,
+		StoreSkillsDir:   storeAbs[0].Path,
 		Entries:          []StatusEntry{},
 	}
 
@@ -57,16 +92,29 @@ func InspectStatus(storeSkillsDir, projectSkillsDir string) (StatusReport, error
 		return StatusReport{}, err
 	}
 
+	// Store problems such as duplicate names surface elsewhere; without
+	// discovery, copies are only judged by their recorded provenance.
+	var storeSkills map[string]Skill
+	if visible, _, err := DiscoverStores(storeAbs); err == nil {
+		storeSkills = make(map[string]Skill, len(visible))
+		for _, skill := range visible {
+			storeSkills[skill.Name] = skill
+		}
+	}
+
 	report.Entries = make([]StatusEntry, 0, len(entries))
 	for _, entry := range entries {
 		entryPath := filepath.Join(projectAbs, entry.Name())
+		if IsIndexFile(entry.Name()) {
+			continue
+		}
 
 		if entry.Type()&os.ModeSymlink == 0 {
-			report.Entries = append(report.Entries, StatusEntry{
-				Name:   entry.Name(),
-				Path:   entryPath,
-				Status: StatusConflict,
-			})
+			classified, err := classifyCopy(entry.Name(), entryPath, entry.IsDir(), storeSkills, storeAbs, lock)
+			if err != nil {
+				return StatusReport{}, err
+			}
+			report.Entries = append(report.Entries, classified)
 			continue
 		}
 
@@ -96,14 +144,20 @@ func InspectStatus(storeSkillsDir, projectSkillsDir string) (StatusReport, error
 		}
 
 		status := StatusExternal
-		if isWithinDir(targetAbs, storeAbs) {
-			status = StatusLinked
+		storeName := ""
+		for _, store := range storeAbs {
+			if isWithinDir(targetAbs, store.Path) {
+				status = StatusLinked
+				storeName = store.Name
+				break
+			}
 		}
 
 		report.Entries = append(report.Entries, StatusEntry{
 			Name:   entry.Name(),
 			Path:   entryPath,
 			Status: status,
+			Store:  storeName,
 		})
 	}
 
@@ -114,6 +168,71 @@ func InspectStatus(storeSkillsDir, projectSkillsDir string) (StatusReport, error
 	return report, nil
 }
 
+// classifyCopy classifies a project entry that is not a symlink. storeSkills
+// is nil when the stores could not be discovered.
+func classifyCopy(name, path string, isDir bool, storeSkills map[string]Skill, stores []StoreDir, lock Lockfile) (StatusEntry, error) {
+	entry := StatusEntry{Name: name, Path: path, Status: StatusConflict}
+	if !isDir {
+		return entry, nil
+	}
+
+	if recorded, ok := lock.Skills[name]; ok {
+		if _, err := os.Stat(recorded.Source); err == nil {
+			drift, err := recorded.Drift(path)
+			if err != nil {
+				return StatusEntry{}, err
+			}
+			entry.Store = storeNameFor(recorded.Source, stores)
+			switch {
+			case drift.ProjectChanged && drift.StoreChanged:
+				entry.Status = StatusConflict
+			case drift.ProjectChanged:
+				entry.Status = StatusModified
+			case drift.StoreChanged:
+				entry.Status = StatusOutdated
+			default:
+				entry.Status = StatusCopied
+			}
+			return entry, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return StatusEntry{}, err
+		}
+	}
+
+	if storeSkills == nil {
+		return entry, nil
+	}
+	skill, ok := storeSkills[name]
+	if !ok {
+		entry.Status = StatusProjectOnly
+		return entry, nil
+	}
+
+	entry.Store = skill.Store
+	projectDigest, err := DigestDir(path)
+	if err != nil {
+		return StatusEntry{}, err
+	}
+	storeDigest, err := DigestDir(skill.Path)
+	if err != nil {
+		return StatusEntry{}, err
+	}
+	if projectDigest == storeDigest {
+		entry.Status = StatusCopied
+	}
+	return entry, nil
+}
+
+// storeNameFor names the store holding path, or "" when none does.
+func storeNameFor(path string, stores []StoreDir) string {
+	for _, store := range stores {
+		if isWithinDir(path, store.Path) {
+			return store.Name
+		}
+	}
+	return ""
+}
+
 func isWithinDir(path, dir string) bool {
 	rel, err := filepath.Rel(dir, path)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestInspectStatusClassifiesEntries(t *testing.T) {
@@ -82,3 +83,88 @@ func TestInspectStatusMissingProjectDir(t *testing.T) {
 		t.Fatalf("InspectStatus() entries len = %d", len(report.Entries))
 	}
 }
+
+func TestInspectStatusStoresTreatsAnyStoreAsLinked(t *testing.T) {
+	tmp := t.TempDir()
+	personal := filepath.Join(tmp, "personal")
+	team := filepath.Join(tmp, "team")
+	projectDir := filepath.Join(tmp, "project", ".agents", "skills")
+
+	mustMkdirAll(t, filepath.Join(team, "react"))
+	mustMkdirAll(t, projectDir)
+	if err := os.Symlink(filepath.Join(team, "react"), filepath.Join(projectDir, "react")); err != nil {
+		t.Fatalf("Symlink(react) error = %v", err)
+	}
+
+	report, err := InspectStatusStores([]StoreDir{
+		{Name: "personal", Path: personal},
+		{Name: "team", Path: team},
+	}, projectDir)
+	if err != nil {
+		t.Fatalf("InspectStatusStores() error = %v", err)
+	}
+
+	if report.StoreSkillsDir != personal {
+		t.Fatalf("StoreSkillsDir = %q, want primary store %q", report.StoreSkillsDir, personal)
+	}
+	if len(report.Entries) != 1 {
+		t.Fatalf("entries = %+v, want 1", report.Entries)
+	}
+	if got := report.Entries[0]; got.Status != StatusLinked || got.Store != "team" {
+		t.Fatalf("entry = %+v, want linked from team", got)
+	}
+}
+
+func TestInspectProjectStatusClassifiesCopies(t *testing.T) {
+	tmp := t.TempDir()
+	store := filepath.Join(tmp, "store")
+	projectDir := filepath.Join(tmp, "project", ".agents", "skills")
+
+	for _, name := range []string{"clean", "modified", "outdated", "both", "unrecorded", "diverged"} {
+		mustMkdirAll(t, filepath.Join(store, name))
+		mustWriteFile(t, filepath.Join(store, name, "SKILL.md"), "store "+name)
+		mustMkdirAll(t, filepath.Join(projectDir, name))
+		mustWriteFile(t, filepath.Join(projectDir, name, "SKILL.md"), "store "+name)
+	}
+	mustMkdirAll(t, filepath.Join(projectDir, "local"))
+	mustWriteFile(t, filepath.Join(projectDir, "local", "SKILL.md"), "local")
+
+	lock := Lockfile{}
+	for _, name := range []string{"clean", "modified", "outdated", "both"} {
+		if err := lock.RecordCopy(name, filepath.Join(store, name), filepath.Join(projectDir, name), time.Now()); err != nil {
+			t.Fatalf("RecordCopy(%s) error = %v", name, err)
+		}
+	}
+	mustWriteFile(t, filepath.Join(projectDir, "modified", "SKILL.md"), "edited")
+	mustWriteFile(t, filepath.Join(store, "outdated", "SKILL.md"), "newer")
+	mustWriteFile(t, filepath.Join(projectDir, "both", "SKILL.md"), "edited")
+	mustWriteFile(t, filepath.Join(store, "both", "SKILL.md"), "newer")
+	mustWriteFile(t, filepath.Join(projectDir, "diverged", "SKILL.md"), "edited")
+
+	report, err := InspectProjectStatus([]StoreDir{{Name: "personal", Path: store}}, projectDir, lock)
+	if err != nil {
+		t.Fatalf("InspectProjectStatus() error = %v", err)
+	}
+
+	got := map[string]StatusEntry{}
+	for _, entry := range report.Entries {
+		got[entry.Name] = entry
+	}
+	want := map[string]StatusKind{
+		"clean":      StatusCopied,
+		"modified":   StatusModified,
+		"outdated":   StatusOutdated,
+		"both":       StatusConflict,
+		"unrecorded": StatusCopied,
+		"diverged":   StatusConflict,
+		"local":      StatusProjectOnly,
+	}
+	for name, status := range want {
+		if got[name].Status != status {
+			t.Fatalf("%s status = %q, want %q", name, got[name].Status, status)
+		}
+	}
+	if got["clean"].Store != "personal" || got["unrecorded"].Store != "personal" {
+		t.Fatalf("copy stores = %q, %q, want personal", got["clean"].Store, got["unrecorded"].Store)
+	}
+}
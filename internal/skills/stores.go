@@ -0,0 +1,40 @@
+package skills
+
+import "sort"
+
+// StoreDir is one named store directory in the search path.
+type StoreDir struct {
+	Name string
+	Path string
+}
+
+// DiscoverStores discovers skills across stores in priority order. A skill in
+// an earlier store shadows skills with the same name in later stores; visible
+// and shadowed skills are each sorted by name, then store priority.
+func DiscoverStores(stores []StoreDir) (visible []Skill, shadowed []Skill, err error) {
+	visible = []Skill{}
+	shadowed = []Skill{}
+	seen := map[string]struct{}{}
+	for _, store := range stores {
+		discovered, err := Discover(store.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, skill := range discovered {
+			skill.Store = store.Name
+			if _, exists := seen[skill.Name]; exists {
+				shadowed = append(shadowed, skill)
+				continue
+			}
+			seen[skill.Name] = struct{}{}
+			visible = append(visible, skill)
+		}
+	}
+
+	sortByName := func(list []Skill) {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	}
+	sortByName(visible)
+	sortByName(shadowed)
+	return visible, shadowed, nil
+}
@@ -0,0 +1,47 @@
+package skills
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDiscoverStoresShadowsLaterStores(t *testing.T) {
+	tmp := t.TempDir()
+	personal := filepath.Join(tmp, "personal")
+	team := filepath.Join(tmp, "team")
+
+	mustMkdirAll(t, filepath.Join(personal, "go"))
+	mustMkdirAll(t, filepath.Join(team, "lang", "go"))
+	mustMkdirAll(t, filepath.Join(team, "react"))
+	mustWriteFile(t, filepath.Join(personal, "go", "SKILL.md"), "personal go")
+	mustWriteFile(t, filepath.Join(team, "lang", "go", "SKILL.md"), "team go")
+	mustWriteFile(t, filepath.Join(team, "react", "SKILL.md"), "team react")
+
+	visible, shadowed, err := DiscoverStores([]StoreDir{
+		{Name: "personal", Path: personal},
+		{Name: "team", Path: team},
+		{Name: "missing", Path: filepath.Join(tmp, "missing")},
+	})
+	if err != nil {
+		t.Fatalf("DiscoverStores() error = %v", err)
+	}
+
+	wantVisible := []Skill{
+		{Name: "go", Path: filepath.Join(personal, "go"), Store: "personal"},
+		{Name: "react", Path: filepath.Join(team, "react"), Store: "team"},
+	}
+	if len(visible) != len(wantVisible) {
+		t.Fatalf("visible = %+v, want %+v", visible, wantVisible)
+	}
+	for i := range wantVisible {
+		if !reflect.DeepEqual(visible[i], wantVisible[i]) {
+			t.Fatalf("visible[%d] = %+v, want %+v", i, visible[i], wantVisible[i])
+		}
+	}
+
+	wantShadowed := Skill{Name: "go", Path: filepath.Join(team, "lang", "go"), Store: "team"}
+	if len(shadowed) != 1 || !reflect.DeepEqual(shadowed[0], wantShadowed) {
+		t.Fatalf("shadowed = %+v, want [%+v]", shadowed, wantShadowed)
+	}
+}
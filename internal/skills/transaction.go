@@ -0,0 +1,254 @@
+package skills
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const transactionHeader = "# Written by bond while a batch is applied. Removed when it finishes.\n"
+
+// TransactionJournal is the on-disk record of a batch being applied. A
+// journal left behind means the batch was interrupted and must be rolled back.
+type TransactionJournal struct {
+	Command    string      `yaml:"command"`
+	StartedAt  time.Time   `yaml:"started_at"`
+	Operations []Operation `yaml:"operations"`
+	// Applied counts operations known to have finished. The operation after
+	// them may have been in progress.
+	Applied int `yaml:"applied"`
+}
+
+// Transaction applies a batch of operations all or nothing.
+type Transaction struct {
+	path    string
+	journal TransactionJournal
+}
+
+// BeginTransaction writes a journal for ops at path. It fails when another
+// journal is still there, so an interrupted batch is never overwritten.
+func BeginTransaction(path, command string, ops []Operation) (*Transaction, error) {
+	if _, err := os.Lstat(path); err == nil {
+		return nil, fmt.Errorf("an interrupted batch is recorded in %q; roll it back first", path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	tx := &Transaction{
+		path:    path,
+		journal: TransactionJournal{Command: command, StartedAt: time.Now().UTC(), Operations: ops},
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	if err := tx.save(); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Apply performs every operation in order and records progress in the journal.
+// On failure the caller is expected to call Rollback.
+func (t *Transaction) Apply() error {
+	for t.journal.Applied < len(t.journal.Operations) {
+		op, err := ApplyRecorded(t.journal.Operations[t.journal.Applied])
+		if err != nil {
+			return fmt.Errorf("%s: %w", t.journal.Operations[t.journal.Applied], err)
+		}
+		t.journal.Operations[t.journal.Applied] = op
+		t.journal.Applied++
+		if err := t.save(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Operations returns the batch as applied so far, with what RevertOperation
+// needs recorded on the finished operations.
+func (t *Transaction) Operations() []Operation {
+	return t.journal.Operations
+}
+
+// Commit removes the journal once the batch and its bookkeeping are done.
+func (t *Transaction) Commit() error {
+	return removeJournal(t.path)
+}
+
+// Rollback reverts the applied operations, newest first, and removes the journal.
+func (t *Transaction) Rollback() error {
+	// Apply reports failed operations without leaving them half done, so only
+	// the finished ones need reverting.
+	return rollbackJournal(t.path, t.journal, t.journal.Applied)
+}
+
+// LoadTransactionJournal reads the journal at path. ok is false when there is none.
+func LoadTransactionJournal(path string) (journal TransactionJournal, ok bool, err error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return TransactionJournal{}, false, nil
+		}
+		return TransactionJournal{}, false, err
+	}
+	if err := yaml.Unmarshal(raw, &journal); err != nil {
+		return TransactionJournal{}, false, fmt.Errorf("invalid transaction journal %q: %w", path, err)
+	}
+	return journal, true, nil
+}
+
+// RecoverTransaction rolls back the batch recorded at path, if any, and
+// returns its journal. ok is false when no batch was interrupted.
+func RecoverTransaction(path string) (journal TransactionJournal, ok bool, err error) {
+	journal, ok, err = LoadTransactionJournal(path)
+	if err != nil || !ok {
+		return journal, ok, err
+	}
+	// The operation after the finished ones may have completed before the
+	// journal was updated, so it is reverted too.
+	return journal, true, rollbackJournal(path, journal, journal.Applied+1)
+}
+
+// ApplyOperation performs one link, copy, link removal or tree removal.
+func ApplyOperation(op Operation) error {
+	switch op.Kind {
+	case OpSymlink:
+		return os.Symlink(op.Source, op.Path)
+	case OpCopyTree:
+		result, err := Copy(op.Source, op.Path)
+		if err != nil {
+			return err
+		}
+		if result.Status == CopyStatusConflict {
+			return fmt.Errorf("%q already exists", op.Path)
+		}
+		return nil
+	case OpRemoveLink:
+		removed, err := Unlink(op.Path)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return fmt.Errorf("%q is not a symlink", op.Path)
+		}
+		return nil
+	case OpRemoveTree:
+		return os.RemoveAll(op.Path)
+	case OpMoveTree:
+		if _, err := os.Lstat(op.Path); err == nil {
+			return fmt.Errorf("%q already exists", op.Path)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(op.Path), 0o755); err != nil {
+			return err
+		}
+		return os.Rename(op.Source, op.Path)
+	default:
+		return fmt.Errorf("cannot apply %s operations", op.Kind)
+	}
+}
+
+// ApplyRecorded applies op and returns it with the digest of the copied tree
+// recorded, so RevertOperation later compares against what was copied rather
+// than against the source as it is by then.
+func ApplyRecorded(op Operation) (Operation, error) {
+	if err := ApplyOperation(op); err != nil {
+		return op, err
+	}
+	if op.Kind != OpCopyTree {
+		return op, nil
+	}
+	digest, err := DigestDir(op.Path)
+	if err != nil {
+		return op, err
+	}
+	op.Digest = digest
+	return op, nil
+}
+
+// RevertOperation undoes op when disk still shows its effect. It leaves paths
+// alone that do not look like bond put them there, so reverting an operation
+// that never ran is a no-op.
+func RevertOperation(op Operation) error {
+	switch op.Kind {
+	case OpSymlink:
+		if !isLinkTo(op.Path, op.Source) {
+			return nil
+		}
+		return os.Remove(op.Path)
+	case OpCopyTree:
+		if _, err := os.Lstat(op.Path); errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		copied, err := DigestDir(op.Path)
+		if err != nil {
+			return err
+		}
+		// Without a recorded digest the copy may not have been acknowledged
+		// yet, so the source still holds what was copied.
+		expected := op.Digest
+		if expected == "" {
+			if expected, err = DigestDir(op.Source); err != nil {
+				return err
+			}
+		}
+		if copied != expected {
+			return fmt.Errorf("%q changed since it was copied; left in place", op.Path)
+		}
+		return os.RemoveAll(op.Path)
+	case OpRemoveLink:
+		if _, err := os.Lstat(op.Path); err == nil {
+			return nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return os.Symlink(op.Source, op.Path)
+	default:
+		return fmt.Errorf("cannot revert %s operations", op.Kind)
+	}
+}
+
+// rollbackJournal reverts the first count operations of journal, newest first,
+// and removes the journal. Operations that cannot be reverted are reported, not retried.
+func rollbackJournal(path string, journal TransactionJournal, count int) error {
+	if count > len(journal.Operations) {
+		count = len(journal.Operations)
+	}
+
+	var errs []error
+	for i := count - 1; i >= 0; i-- {
+		if err := RevertOperation(journal.Operations[i]); err != nil {
+			errs = append(errs, fmt.Errorf("revert %s: %w", journal.Operations[i], err))
+		}
+	}
+	if err := removeJournal(path); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (t *Transaction) save() error {
+	raw, err := yaml.Marshal(t.journal)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(t.path, append([]byte(transactionHeader), raw...), 0o644)
+}
+
+func removeJournal(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// isLinkTo reports whether path is a symlink whose target is target.
+func isLinkTo(path, target string) bool {
+	current, err := os.Readlink(path)
+	return err == nil && current == target
+}
@@ -0,0 +1,216 @@
+package skills
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTransactionApplyAndCommit(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "store", "go")
+	project := filepath.Join(tmp, "project")
+	journalPath := filepath.Join(project, ".bond", "transaction.yaml")
+	mustMkdirAll(t, source)
+	mustMkdirAll(t, project)
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "go")
+
+	ops := []Operation{
+		{Kind: OpSymlink, Path: filepath.Join(project, "linked"), Source: source},
+		{Kind: OpCopyTree, Path: filepath.Join(project, "copied"), Source: source},
+	}
+	tx, err := BeginTransaction(journalPath, "link", ops)
+	if err != nil {
+		t.Fatalf("BeginTransaction() error = %v", err)
+	}
+	if _, err := BeginTransaction(journalPath, "link", ops); err == nil {
+		t.Fatal("second BeginTransaction() error = nil, want interrupted batch error")
+	}
+	if err := tx.Apply(); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	journal, ok, err := LoadTransactionJournal(journalPath)
+	if err != nil || !ok {
+		t.Fatalf("LoadTransactionJournal() = %v, %v, want journal", ok, err)
+	}
+	if journal.Command != "link" || journal.Applied != 2 || len(journal.Operations) != 2 {
+		t.Fatalf("journal = %+v, want 2 applied link operations", journal)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if _, err := os.Stat(journalPath); !os.IsNotExist(err) {
+		t.Fatalf("Stat(journal) error = %v, want not exist", err)
+	}
+	if _, err := os.Readlink(filepath.Join(project, "linked")); err != nil {
+		t.Fatalf("Readlink(linked) error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(project, "copied", "SKILL.md")); err != nil {
+		t.Fatalf("Stat(copied) error = %v", err)
+	}
+}
+
+func TestTransactionRollbackRevertsAppliedOperations(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "store", "go")
+	project := filepath.Join(tmp, "project")
+	journalPath := filepath.Join(project, "transaction.yaml")
+	mustMkdirAll(t, source)
+	mustMkdirAll(t, project)
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "go")
+
+	oldLink := filepath.Join(project, "old")
+	if err := os.Symlink(source, oldLink); err != nil {
+		t.Fatalf("Symlink(old) error = %v", err)
+	}
+	blocked := filepath.Join(project, "blocked")
+	mustMkdirAll(t, blocked)
+
+	ops := []Operation{
+		{Kind: OpRemoveLink, Path: oldLink, Source: source},
+		{Kind: OpCopyTree, Path: filepath.Join(project, "copied"), Source: source},
+		{Kind: OpCopyTree, Path: blocked, Source: source},
+	}
+	tx, err := BeginTransaction(journalPath, "copy", ops)
+	if err != nil {
+		t.Fatalf("BeginTransaction() error = %v", err)
+	}
+	err = tx.Apply()
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("Apply() error = %v, want already exists", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+
+	if target, err := os.Readlink(oldLink); err != nil || target != source {
+		t.Fatalf("Readlink(old) = %q, %v, want restored link to %q", target, err, source)
+	}
+	if _, err := os.Lstat(filepath.Join(project, "copied")); !os.IsNotExist(err) {
+		t.Fatalf("Lstat(copied) error = %v, want removed", err)
+	}
+	if _, err := os.Stat(blocked); err != nil {
+		t.Fatalf("Stat(blocked) error = %v, want untouched", err)
+	}
+	if _, err := os.Stat(journalPath); !os.IsNotExist(err) {
+		t.Fatalf("Stat(journal) error = %v, want removed", err)
+	}
+}
+
+func TestRecoverTransactionRevertsInFlightOperation(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "store", "go")
+	project := filepath.Join(tmp, "project")
+	journalPath := filepath.Join(project, "transaction.yaml")
+	mustMkdirAll(t, source)
+	mustMkdirAll(t, project)
+
+	first := filepath.Join(project, "first")
+	second := filepath.Join(project, "second")
+	ops := []Operation{
+		{Kind: OpSymlink, Path: first, Source: source},
+		{Kind: OpSymlink, Path: second, Source: source},
+	}
+	tx, err := BeginTransaction(journalPath, "link", ops)
+	if err != nil {
+		t.Fatalf("BeginTransaction() error = %v", err)
+	}
+	// Simulate a crash after both links exist but before the journal saw the second.
+	tx.journal.Applied = 1
+	if err := tx.save(); err != nil {
+		t.Fatalf("save() error = %v", err)
+	}
+	for _, path := range []string{first, second} {
+		if err := os.Symlink(source, path); err != nil {
+			t.Fatalf("Symlink(%q) error = %v", path, err)
+		}
+	}
+
+	journal, ok, err := RecoverTransaction(journalPath)
+	if err != nil || !ok {
+		t.Fatalf("RecoverTransaction() = %v, %v, want recovered", ok, err)
+	}
+	if journal.Command != "link" {
+		t.Fatalf("journal.Command = %q, want link", journal.Command)
+	}
+	for _, path := range []string{first, second} {
+		if _, err := os.Lstat(path); !os.IsNotExist(err) {
+			t.Fatalf("Lstat(%q) error = %v, want removed", path, err)
+		}
+	}
+
+	if _, ok, err := RecoverTransaction(journalPath); ok || err != nil {
+		t.Fatalf("second RecoverTransaction() = %v, %v, want nothing to recover", ok, err)
+	}
+}
+
+func TestRevertOperationLeavesForeignPathsAlone(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "store", "go")
+	other := filepath.Join(tmp, "store", "other")
+	mustMkdirAll(t, source)
+	mustMkdirAll(t, other)
+
+	link := filepath.Join(tmp, "link")
+	if err := os.Symlink(other, link); err != nil {
+		t.Fatalf("Symlink() error = %v", err)
+	}
+	if err := RevertOperation(Operation{Kind: OpSymlink, Path: link, Source: source}); err != nil {
+		t.Fatalf("RevertOperation(symlink) error = %v", err)
+	}
+	if _, err := os.Readlink(link); err != nil {
+		t.Fatalf("Readlink() error = %v, want link to other kept", err)
+	}
+
+	copied := filepath.Join(tmp, "copied")
+	mustMkdirAll(t, copied)
+	mustWriteFile(t, filepath.Join(copied, "SKILL.md"), "edited")
+	if err := RevertOperation(Operation{Kind: OpCopyTree, Path: copied, Source: source}); err == nil {
+		t.Fatal("RevertOperation(copy) error = nil, want changed copy error")
+	}
+	if _, err := os.Stat(copied); err != nil {
+		t.Fatalf("Stat(copied) error = %v, want kept", err)
+	}
+}
+
+func TestRevertOperationComparesCopyWithWhatWasCopied(t *testing.T) {
+	tmp := t.TempDir()
+	source := filepath.Join(tmp, "store", "go")
+	mustMkdirAll(t, source)
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "go")
+
+	copied := filepath.Join(tmp, "copied")
+	op, err := ApplyRecorded(Operation{Kind: OpCopyTree, Path: copied, Source: source})
+	if err != nil {
+		t.Fatalf("ApplyRecorded() error = %v", err)
+	}
+	if op.Digest == "" {
+		t.Fatal("ApplyRecorded() digest is empty, want digest of the copy")
+	}
+
+	// The store moving on does not make the untouched copy look edited.
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "go, revised")
+	if err := RevertOperation(op); err != nil {
+		t.Fatalf("RevertOperation() error = %v", err)
+	}
+	if _, err := os.Lstat(copied); !os.IsNotExist(err) {
+		t.Fatalf("Lstat(copied) error = %v, want removed", err)
+	}
+
+	// An edited copy is still kept, even when it now matches the source.
+	op, err = ApplyRecorded(Operation{Kind: OpCopyTree, Path: copied, Source: source})
+	if err != nil {
+		t.Fatalf("ApplyRecorded() error = %v", err)
+	}
+	mustWriteFile(t, filepath.Join(copied, "SKILL.md"), "edited")
+	mustWriteFile(t, filepath.Join(source, "SKILL.md"), "edited")
+	if err := RevertOperation(op); err == nil {
+		t.Fatal("RevertOperation() error = nil, want changed copy error")
+	}
+	if _, err := os.Stat(copied); err != nil {
+		t.Fatalf("Stat(copied) error = %v, want kept", err)
+	}
+}
@@ -0,0 +1,71 @@
+package skills
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const trashHeader = "# Generated by bond. Skills removed with bond remove; bring them back with bond restore.\n"
+
+// TrashEntry records one store skill moved to the trash.
+type TrashEntry struct {
+	Name string `yaml:"name" json:"name"`
+	// Path is where the skill is kept in the trash.
+	Path string `yaml:"path" json:"path"`
+	// Origin is where the skill lived in the store and is restored to.
+	Origin    string    `yaml:"origin" json:"origin"`
+	RemovedAt time.Time `yaml:"removed_at" json:"removed_at"`
+}
+
+// Trash lists the skills removed from one store, oldest first.
+type Trash struct {
+	Entries []TrashEntry `yaml:"entries"`
+}
+
+// LoadTrash reads the trash index at path. A missing file yields an empty trash.
+func LoadTrash(path string) (Trash, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Trash{}, nil
+		}
+		return Trash{}, err
+	}
+
+	var trash Trash
+	if err := yaml.Unmarshal(raw, &trash); err != nil {
+		return Trash{}, fmt.Errorf("invalid trash index %q: %w", path, err)
+	}
+	return trash, nil
+}
+
+// SaveTrash writes trash to path.
+func SaveTrash(path string, trash Trash) error {
+	raw, err := yaml.Marshal(trash)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte(trashHeader), raw...), 0o644)
+}
+
+// Add records a skill moved from origin to path.
+func (t *Trash) Add(name, path, origin string, at time.Time) TrashEntry {
+	entry := TrashEntry{Name: name, Path: path, Origin: origin, RemovedAt: at.UTC().Truncate(time.Second)}
+	t.Entries = append(t.Entries, entry)
+	return entry
+}
+
+// Forget drops the entry kept at path.
+func (t *Trash) Forget(path string) {
+	kept := t.Entries[:0]
+	for _, entry := range t.Entries {
+		if entry.Path != path {
+			kept = append(kept, entry)
+		}
+	}
+	t.Entries = kept
+}
@@ -0,0 +1,32 @@
+package skills
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTrashRoundTripAndForget(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trash.yaml")
+	at := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	trash := Trash{}
+	trash.Add("go", "/store/.bond/trash/go-1", "/store/go", at)
+	trash.Add("go", "/store/.bond/trash/go-2", "/store/lang/go", at.Add(time.Minute))
+	if err := SaveTrash(path, trash); err != nil {
+		t.Fatalf("SaveTrash() error = %v", err)
+	}
+
+	loaded, err := LoadTrash(path)
+	if err != nil {
+		t.Fatalf("LoadTrash() error = %v", err)
+	}
+	if len(loaded.Entries) != 2 || loaded.Entries[1].Origin != "/store/lang/go" || !loaded.Entries[0].RemovedAt.Equal(at) {
+		t.Fatalf("entries = %+v", loaded.Entries)
+	}
+
+	loaded.Forget("/store/.bond/trash/go-1")
+	if len(loaded.Entries) != 1 || loaded.Entries[0].Path != "/store/.bond/trash/go-2" {
+		t.Fatalf("entries after Forget = %+v, want go-2 only", loaded.Entries)
+	}
+}
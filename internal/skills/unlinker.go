@@ -42,6 +42,26 @@ func DiscoverLinked(projectSkillsDir string) ([]Entry, error) {
 	return linked, nil
 }
 
+// PlanUnlink reports what Unlink would do without touching disk.
+func PlanUnlink(path string) (bool, []Operation, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	if info.Mode()&os.ModeSymlink == 0 {
+		return false, nil, nil
+	}
+	// Keep the old target so the removal can be reverted.
+	target, err := os.Readlink(path)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, []Operation{{Kind: OpRemoveLink, Path: path, Source: target}}, nil
+}
+
 // Unlink removes path only when it exists and is a symlink.
 func Unlink(path string) (bool, error) {
 	// Lstat keeps symlink metadata instead of following the link.
@@ -9,21 +9,19 @@ import (
 	"sort"
 	"strings"
 	"unicode/utf8"
-
-	"gopkg.in/yaml.v3"
 )
 
 // ValidationIssue describes a single validation rule violation.
 type ValidationIssue struct {
-	Rule    string
-	Message string
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
 }
 
 // ValidationResult captures validation issues for a single skill directory.
 type ValidationResult struct {
-	Name   string
-	Path   string
-	Issues []ValidationIssue
+	Name   string            `json:"name"`
+	Path   string            `json:"path"`
+	Issues []ValidationIssue `json:"issues"`
 }
 
 // ValidateStoreAll validates all discovered store skills in deterministic name order.
@@ -99,8 +97,8 @@ func ValidateSkillDir(skillDir string) (ValidationResult, error) {
 		return result, nil
 	}
 
-	meta := map[string]any{}
-	if err := yaml.Unmarshal([]byte(frontmatter), &meta); err != nil {
+	meta, fieldIssues, err := ParseMetadata(frontmatter)
+	if err != nil {
 		result.Issues = append(result.Issues, ValidationIssue{
 			Rule:    "frontmatter",
 			Message: fmt.Sprintf("invalid YAML in SKILL.md frontmatter: %v", err),
@@ -108,8 +106,8 @@ func ValidateSkillDir(skillDir string) (ValidationResult, error) {
 		return result, nil
 	}
 
-	name, ok := requiredString(meta, "name")
-	if !ok {
+	name := meta.Name
+	if name == "" {
 		result.Issues = append(result.Issues, ValidationIssue{
 			Rule:    "name",
 			Message: `frontmatter field "name" is required and must be a non-empty string`,
@@ -136,8 +134,8 @@ func ValidateSkillDir(skillDir string) (ValidationResult, error) {
 		}
 	}
 
-	description, ok := requiredString(meta, "description")
-	if !ok {
+	description := meta.Description
+	if description == "" {
 		result.Issues = append(result.Issues, ValidationIssue{
 			Rule:    "description",
 			Message: `frontmatter field "description" is required and must be a non-empty string`,
@@ -152,44 +150,35 @@ func ValidateSkillDir(skillDir string) (ValidationResult, error) {
 		}
 	}
 
+	result.Issues = append(result.Issues, fieldIssues...)
 	return result, nil
 }
 
-func requiredString(meta map[string]any, field string) (string, bool) {
-	value, ok := meta[field]
-	if !ok {
-		return "", false
-	}
-	str, ok := value.(string)
-	if !ok {
-		return "", false
-	}
-	str = strings.TrimSpace(str)
-	if str == "" {
-		return "", false
-	}
-	return str, true
+func extractFrontmatter(contents string) (string, bool) {
+	frontmatter, _, ok := splitFrontmatter(contents)
+	return frontmatter, ok
 }
 
-func extractFrontmatter(contents string) (string, bool) {
+// splitFrontmatter separates the YAML frontmatter of a SKILL.md from its body.
+func splitFrontmatter(contents string) (string, string, bool) {
 	normalized := strings.ReplaceAll(strings.TrimPrefix(contents, "\uFEFF"), "\r\n", "\n")
 	if !strings.HasPrefix(normalized, "---\n") {
-		return "", false
+		return "", "", false
 	}
 
 	rest := normalized[len("---\n"):]
 	closing := strings.Index(rest, "\n---\n")
 	if closing >= 0 {
-		return rest[:closing], true
+		return rest[:closing], rest[closing+len("\n---\n"):], true
 	}
 	if strings.HasSuffix(rest, "\n---") {
-		return strings.TrimSuffix(rest, "\n---"), true
+		return strings.TrimSuffix(rest, "\n---"), "", true
 	}
 	if rest == "---" {
-		return "", true
+		return "", "", true
 	}
 
-	return "", false
+	return "", "", false
 }
 
 func findSkillDirByName(storeDir, name string) (string, error) {
@@ -209,6 +198,9 @@ func findSkillDirByName(storeDir, name string) (string, error) {
 		if !d.IsDir() {
 			return nil
 		}
+		if isHiddenSubdir(path, storeAbs) {
+			return filepath.SkipDir
+		}
 		if filepath.Clean(path) == filepath.Clean(storeAbs) {
 			return nil
 		}
@@ -1,7 +1,6 @@
 package skills
 
 import (
-	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -10,8 +9,8 @@ import (
 func TestValidateSkillDirValid(t *testing.T) {
 	tmp := t.TempDir()
 	skillDir := filepath.Join(tmp, "go")
-	mustMkdirAllValidate(t, skillDir)
-	mustWriteFileValidate(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: go\ndescription: Go skill\n---\n# Go\n")
+	mustMkdirAll(t, skillDir)
+	mustWriteFile(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: go\ndescription: Go skill\n---\n# Go\n")
 
 	result, err := ValidateSkillDir(skillDir)
 	if err != nil {
@@ -25,7 +24,7 @@ func TestValidateSkillDirValid(t *testing.T) {
 func TestValidateSkillDirMissingSkillFile(t *testing.T) {
 	tmp := t.TempDir()
 	skillDir := filepath.Join(tmp, "go")
-	mustMkdirAllValidate(t, skillDir)
+	mustMkdirAll(t, skillDir)
 
 	result, err := ValidateSkillDir(skillDir)
 	if err != nil {
@@ -42,8 +41,8 @@ func TestValidateSkillDirMissingSkillFile(t *testing.T) {
 func TestValidateSkillDirRequiresFrontmatter(t *testing.T) {
 	tmp := t.TempDir()
 	skillDir := filepath.Join(tmp, "go")
-	mustMkdirAllValidate(t, skillDir)
-	mustWriteFileValidate(t, filepath.Join(skillDir, "SKILL.md"), "# Missing frontmatter\n")
+	mustMkdirAll(t, skillDir)
+	mustWriteFile(t, filepath.Join(skillDir, "SKILL.md"), "# Missing frontmatter\n")
 
 	result, err := ValidateSkillDir(skillDir)
 	if err != nil {
@@ -60,8 +59,8 @@ func TestValidateSkillDirRequiresFrontmatter(t *testing.T) {
 func TestValidateSkillDirChecksRequiredFieldsAndNameRules(t *testing.T) {
 	tmp := t.TempDir()
 	skillDir := filepath.Join(tmp, "go")
-	mustMkdirAllValidate(t, skillDir)
-	mustWriteFileValidate(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: Go--Skill\ndescription: \"\"\n---\n")
+	mustMkdirAll(t, skillDir)
+	mustWriteFile(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: Go--Skill\ndescription: \"\"\n---\n")
 
 	result, err := ValidateSkillDir(skillDir)
 	if err != nil {
@@ -86,11 +85,11 @@ func TestValidateSkillDirChecksRequiredFieldsAndNameRules(t *testing.T) {
 func TestValidateSkillDirLengthBounds(t *testing.T) {
 	tmp := t.TempDir()
 	skillDir := filepath.Join(tmp, "go")
-	mustMkdirAllValidate(t, skillDir)
+	mustMkdirAll(t, skillDir)
 
 	longName := strings.Repeat("a", 65)
 	longDescription := strings.Repeat("d", 1025)
-	mustWriteFileValidate(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: "+longName+"\ndescription: "+longDescription+"\n---\n")
+	mustWriteFile(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: "+longName+"\ndescription: "+longDescription+"\n---\n")
 
 	result, err := ValidateSkillDir(skillDir)
 	if err != nil {
@@ -109,8 +108,8 @@ func TestValidateStoreByNameFindsNestedDir(t *testing.T) {
 	tmp := t.TempDir()
 	globalDir := filepath.Join(tmp, "global")
 	skillDir := filepath.Join(globalDir, "lang", "go")
-	mustMkdirAllValidate(t, skillDir)
-	mustWriteFileValidate(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: go\ndescription: Go skill\n---\n")
+	mustMkdirAll(t, skillDir)
+	mustWriteFile(t, filepath.Join(skillDir, "SKILL.md"), "---\nname: go\ndescription: Go skill\n---\n")
 
 	result, err := ValidateStoreByName(globalDir, "go")
 	if err != nil {
@@ -124,8 +123,8 @@ func TestValidateStoreByNameFindsNestedDir(t *testing.T) {
 func TestValidateStoreByNameAmbiguousReturnsError(t *testing.T) {
 	tmp := t.TempDir()
 	globalDir := filepath.Join(tmp, "global")
-	mustMkdirAllValidate(t, filepath.Join(globalDir, "team-a", "go"))
-	mustMkdirAllValidate(t, filepath.Join(globalDir, "team-b", "go"))
+	mustMkdirAll(t, filepath.Join(globalDir, "team-a", "go"))
+	mustMkdirAll(t, filepath.Join(globalDir, "team-b", "go"))
 
 	_, err := ValidateStoreByName(globalDir, "go")
 	if err == nil {
@@ -142,10 +141,10 @@ func TestValidateStoreAllValidatesDiscoveredSkills(t *testing.T) {
 	goDir := filepath.Join(globalDir, "lang", "go")
 	rustDir := filepath.Join(globalDir, "systems", "rust")
 
-	mustMkdirAllValidate(t, goDir)
-	mustMkdirAllValidate(t, rustDir)
-	mustWriteFileValidate(t, filepath.Join(goDir, "SKILL.md"), "---\nname: go\ndescription: Go skill\n---\n")
-	mustWriteFileValidate(t, filepath.Join(rustDir, "SKILL.md"), "---\nname: rust\ndescription: \"\"\n---\n")
+	mustMkdirAll(t, goDir)
+	mustMkdirAll(t, rustDir)
+	mustWriteFile(t, filepath.Join(goDir, "SKILL.md"), "---\nname: go\ndescription: Go skill\n---\n")
+	mustWriteFile(t, filepath.Join(rustDir, "SKILL.md"), "---\nname: rust\ndescription: \"\"\n---\n")
 
 	results, err := ValidateStoreAll(globalDir)
 	if err != nil {
@@ -172,17 +171,3 @@ func joinIssues(issues []ValidationIssue) string {
 	}
 	return strings.Join(parts, "\n")
 }
-
-func mustMkdirAllValidate(t *testing.T, path string) {
-	t.Helper()
-	if err := os.MkdirAll(path, 0o755); err != nil {
-		t.Fatalf("MkdirAll(%q) error = %v", path, err)
-	}
-}
-
-func mustWriteFileValidate(t *testing.T, path, contents string) {
-	t.Helper()
-	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
-		t.Fatalf("WriteFile(%q) error = %v", path, err)
-	}
-}